@@ -0,0 +1,124 @@
+// Package audit records security-relevant events (logins, role changes,
+// deletions...) to the append-only audit_events table. It is shared by
+// auth-service and user-service, which use the same database.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/utils"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+)
+
+// Event types
+const (
+	LoginSucceeded     = "login.succeeded"
+	LoginFailed        = "login.failed"
+	Logout             = "logout"
+	UserRegistered     = "user.registered"
+	PasswordChanged    = "password.changed"
+	RoleChanged        = "user.role_changed"
+	PermissionsChanged = "user.permissions_changed"
+	UserDeleted        = "user.deleted"
+	UserRestored       = "user.restored"
+	UserPurged         = "user.purged"
+	DataExported       = "user.data_exported"
+	StudentTransferred = "cohort.student_transferred"
+	SessionRevoked     = "session.revoked"
+	TwoFactorEnabled   = "two_factor.enabled"
+	TwoFactorDisabled  = "two_factor.disabled"
+)
+
+// Event describes what happened; IP and request ID are taken from the context
+type Event struct {
+	Type     string
+	ActorID  string // Defaults to the authenticated caller
+	TargetID string
+	Details  map[string]interface{}
+}
+
+// ✅ Append an event to the audit log (best effort: failures are logged, never returned)
+func Record(ctx context.Context, db *gorm.DB, event Event) {
+	if db == nil {
+		return
+	}
+
+	actorID := event.ActorID
+	if actorID == "" {
+		actorID = authz.UserID(ctx)
+	}
+	details := "{}"
+	if len(event.Details) > 0 {
+		if data, err := json.Marshal(event.Details); err == nil {
+			details = string(data)
+		}
+	}
+
+	err := db.Create(&models.AuditEvent{
+		Type:      event.Type,
+		ActorID:   actorID,
+		TargetID:  event.TargetID,
+		IPAddress: utils.ClientIP(ctx),
+		RequestID: RequestID(ctx),
+		Details:   details,
+	}).Error
+	if err != nil {
+		fmt.Printf("⚠️ Failed to record audit event %s: %v\n", event.Type, err)
+	}
+}
+
+// RequestID returns the `x-request-id` set by the grpc-gateway, if any
+func RequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-request-id"); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// ✅ Strip a user's personal data (IP addresses, email) from the events about
+// them, keeping what happened and when. Call it inside the erasure transaction.
+func Anonymize(tx *gorm.DB, userID, email string) error {
+	if err := tx.Exec("SET LOCAL audit.anonymize = 'on'").Error; err != nil {
+		return err
+	}
+	return tx.Exec(`
+		UPDATE audit_events SET ip_address = '', details = details - 'email'
+		WHERE actor_id = ? OR target_id = ? OR LOWER(details->>'email') = LOWER(?)`,
+		userID, userID, email).Error
+}
+
+// ✅ Create the table and forbid UPDATE/DELETE on it at the database level
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&models.AuditEvent{}); err != nil {
+		return err
+	}
+	return db.Exec(`
+		CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+		BEGIN
+			-- Only Anonymize may rewrite rows, and only their personal data
+			IF TG_OP = 'UPDATE' AND current_setting('audit.anonymize', true) = 'on'
+				AND NEW.id = OLD.id AND NEW.type = OLD.type AND NEW.created_at = OLD.created_at THEN
+				RETURN NEW;
+			END IF;
+			RAISE EXCEPTION 'audit_events is append-only';
+		END;
+		$$ LANGUAGE plpgsql;
+
+		DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'audit_events_append_only') THEN
+				CREATE TRIGGER audit_events_append_only
+					BEFORE UPDATE OR DELETE ON audit_events
+					FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+			END IF;
+		END
+		$$;`).Error
+}
@@ -0,0 +1,262 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/audit"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/mailer"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/utils"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// Generic replies so these endpoints can't be used to discover registered emails
+const (
+	passwordResetRequestedMessage = "✅ If the email is registered, a password reset link has been sent"
+	verificationSentMessage       = "✅ If the email is registered and unverified, a verification link has been sent"
+)
+
+// ✅ Email a single-use password reset link
+func (s *AuthServiceServer) RequestPasswordReset(ctx context.Context, req *auth.RequestPasswordResetRequest) (*auth.RequestPasswordResetResponse, error) {
+	var user models.User
+	if err := database.DB.Select("id, name, email").Where("email = ?", req.Email).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &auth.RequestPasswordResetResponse{Message: passwordResetRequestedMessage}, nil
+		}
+		return nil, errDatabase
+	}
+
+	// Failures are only logged: an error here but not for unknown emails
+	// would tell callers which accounts exist
+	token, err := createAccountToken(user.ID, models.TokenPurposePasswordReset, passwordResetTTL())
+	if err != nil {
+		fmt.Println("⚠️ Failed to create password reset token:", err)
+		return &auth.RequestPasswordResetResponse{Message: passwordResetRequestedMessage}, nil
+	}
+
+	err = s.mailer().Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your NeetChamp password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to choose a new password. It expires in %s.\n\n%s\n\nIf you didn't ask for this, you can ignore this email.\n",
+			user.Name, passwordResetTTL(), appLink("/reset-password", token)),
+	})
+	if err != nil {
+		fmt.Println("⚠️ Failed to send password reset email:", err)
+	}
+
+	return &auth.RequestPasswordResetResponse{Message: passwordResetRequestedMessage}, nil
+}
+
+// ✅ Set a new password using a reset token & sign out every session
+func (s *AuthServiceServer) ConfirmPasswordReset(ctx context.Context, req *auth.ConfirmPasswordResetRequest) (*auth.ConfirmPasswordResetResponse, error) {
+	if len(req.NewPassword) < 8 {
+		return nil, errWeakPassword
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, apperr.Internal("failed to hash password")
+	}
+
+	var user models.User
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		userID, err := consumeAccountToken(tx, req.Token, models.TokenPurposePasswordReset)
+		if err != nil {
+			return err
+		}
+		if err := tx.Select("id, email").First(&user, userID).Error; err != nil {
+			return err
+		}
+		// Following the reset link also proves ownership of the email
+		return tx.Model(&user).Updates(map[string]interface{}{"password": string(hashedPassword), "email_verified": true}).Error
+	})
+	if err != nil {
+		return nil, errInvalidResetToken
+	}
+
+	utils.DeleteCachedUser(user.Email)
+	if _, err := revokeAllSessions(user.ID, ""); err != nil {
+		fmt.Println("⚠️ Failed to revoke sessions after password reset:", err)
+	}
+
+	userID := fmt.Sprintf("%d", user.ID)
+	audit.Record(ctx, database.DB, audit.Event{
+		Type:     audit.PasswordChanged,
+		ActorID:  userID,
+		TargetID: userID,
+		Details:  map[string]interface{}{"method": "reset_link"},
+	})
+
+	return &auth.ConfirmPasswordResetResponse{Message: "✅ Password updated successfully"}, nil
+}
+
+// ✅ (Re)send the email verification link, to the caller or to the given email
+func (s *AuthServiceServer) SendVerificationEmail(ctx context.Context, req *auth.SendVerificationEmailRequest) (*auth.SendVerificationEmailResponse, error) {
+	query := database.DB.Select("id, name, email, email_verified")
+	if req.Email != "" {
+		query = query.Where("email = ?", req.Email)
+	} else if userID := authz.UserID(ctx); userID != "" {
+		query = query.Where("id = ?", userID)
+	} else {
+		return nil, apperr.InvalidField("email", "email is required")
+	}
+
+	var user models.User
+	if err := query.First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &auth.SendVerificationEmailResponse{Message: verificationSentMessage}, nil
+		}
+		return nil, errDatabase
+	}
+	if user.EmailVerified {
+		return &auth.SendVerificationEmailResponse{Message: verificationSentMessage}, nil
+	}
+
+	// Only logged, like for RequestPasswordReset
+	if err := s.sendVerificationEmail(ctx, user); err != nil {
+		fmt.Println("⚠️ Failed to send verification email:", err)
+	}
+
+	return &auth.SendVerificationEmailResponse{Message: verificationSentMessage}, nil
+}
+
+// ✅ Mark the email as verified using the emailed token
+func (s *AuthServiceServer) VerifyEmail(ctx context.Context, req *auth.VerifyEmailRequest) (*auth.VerifyEmailResponse, error) {
+	var user models.User
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		userID, err := consumeAccountToken(tx, req.Token, models.TokenPurposeEmailVerification)
+		if err != nil {
+			return err
+		}
+		if err := tx.Select("id, email").First(&user, userID).Error; err != nil {
+			return err
+		}
+		return tx.Model(&user).Update("email_verified", true).Error
+	})
+	if err != nil {
+		return nil, errInvalidVerifyToken
+	}
+
+	utils.DeleteCachedUser(user.Email)
+	return &auth.VerifyEmailResponse{Message: "✅ Email verified successfully"}, nil
+}
+
+func (s *AuthServiceServer) sendVerificationEmail(ctx context.Context, user models.User) error {
+	token, err := createAccountToken(user.ID, models.TokenPurposeEmailVerification, emailVerificationTTL())
+	if err != nil {
+		return err
+	}
+
+	return s.mailer().Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your NeetChamp email",
+		Body: fmt.Sprintf("Hi %s,\n\nWelcome to NeetChamp! Confirm your email address with the link below. It expires in %s.\n\n%s\n",
+			user.Name, emailVerificationTTL(), appLink("/verify-email", token)),
+	})
+}
+
+func (s *AuthServiceServer) mailer() mailer.Sender {
+	if s.Mailer == nil {
+		return &mailer.LogSender{}
+	}
+	return s.Mailer
+}
+
+// ✅ Issue a single-use token, invalidating older unused ones for the same purpose
+func createAccountToken(userID uint, purpose string, ttl time.Duration) (string, error) {
+	token, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.AccountToken{}).
+			Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+			Update("used_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&models.AccountToken{
+			UserID:    userID,
+			Purpose:   purpose,
+			TokenHash: utils.HashToken(token),
+			ExpiresAt: time.Now().Add(ttl),
+		}).Error
+	})
+	return token, err
+}
+
+// ✅ Atomically mark a token as used and return its user
+func consumeAccountToken(tx *gorm.DB, token, purpose string) (uint, error) {
+	record, err := findAccountToken(tx, token, purpose)
+	if err != nil {
+		return 0, err
+	}
+	if err := markAccountTokenUsed(tx, record.ID); err != nil {
+		return 0, err
+	}
+	return record.UserID, nil
+}
+
+// ✅ Look up an unused, unexpired token without consuming it
+func findAccountToken(tx *gorm.DB, token, purpose string) (*models.AccountToken, error) {
+	if token == "" {
+		return nil, errors.New("token is required")
+	}
+
+	var record models.AccountToken
+	err := tx.Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", utils.HashToken(token), purpose, time.Now()).
+		First(&record).Error
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// ✅ Mark a token as used; fails if another request consumed it first
+func markAccountTokenUsed(tx *gorm.DB, id uint) error {
+	result := tx.Model(&models.AccountToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("token already used")
+	}
+	return nil
+}
+
+// ✅ Login refuses unverified accounts when REQUIRE_EMAIL_VERIFICATION=true
+func requireEmailVerification() bool {
+	return os.Getenv("REQUIRE_EMAIL_VERIFICATION") == "true"
+}
+
+func passwordResetTTL() time.Duration {
+	return utils.DurationFromEnv("PASSWORD_RESET_TTL", time.Hour)
+}
+
+func emailVerificationTTL() time.Duration {
+	return utils.DurationFromEnv("EMAIL_VERIFICATION_TTL", 48*time.Hour)
+}
+
+// appLink builds a link into the client app (APP_BASE_URL) carrying the token
+func appLink(path, token string) string {
+	base := strings.TrimSuffix(os.Getenv("APP_BASE_URL"), "/")
+	if base == "" {
+		base = "http://localhost:3000"
+	}
+	return base + path + "?token=" + url.QueryEscape(token)
+}
@@ -5,9 +5,15 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/audit"
 	"github.com/Aditya-PS-05/NeetChamp/auth-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/mailer"
 	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/oidc"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/outbox"
 	"github.com/Aditya-PS-05/NeetChamp/auth-service/utils"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
 	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
 
 	"golang.org/x/crypto/bcrypt"
@@ -16,106 +22,223 @@ import (
 
 type AuthServiceServer struct {
 	auth.UnimplementedAuthServiceServer
+	Mailer    mailer.Sender
+	Providers oidc.Providers // OpenID Connect providers for LoginWithProvider
 }
 
 // ✅ Register user with transaction & duplicate email check
 func (s *AuthServiceServer) Register(ctx context.Context, req *auth.RegisterRequest) (*auth.RegisterResponse, error) {
-	// Check if email already exists
+	// Check if email already exists (deleted accounts keep theirs until erased)
 	var existingUser models.User
-	if err := database.DB.Where("email = ?", req.Email).First(&existingUser).Error; err == nil {
-		return nil, errors.New("❌ email already in use")
+	if err := database.DB.Unscoped().Where("email = ?", req.Email).First(&existingUser).Error; err == nil {
+		return nil, errEmailTaken
 	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
-		return nil, errors.New("❌ failed to hash password")
+		return nil, apperr.Internal("failed to hash password")
 	}
 
 	user := models.User{
 		Name:     req.Name,
 		Email:    req.Email,
 		Password: string(hashedPassword),
-		Role:     req.Role,
+		Role:     authz.RoleStudent, // Elevated roles are assigned by admins only
 	}
 
-	// ✅ Use transaction to prevent partial failures
-	tx := database.DB.Begin()
-	if err := tx.Create(&user).Error; err != nil {
-		tx.Rollback()
-		return nil, errors.New("❌ failed to create user")
+	// ✅ Create the user and announce it (user-service provisions the profile) atomically
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+		return publishUserRegistered(tx, user)
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to create user")
+	}
+
+	userID := fmt.Sprintf("%d", user.ID)
+	audit.Record(ctx, database.DB, audit.Event{Type: audit.UserRegistered, ActorID: userID, TargetID: userID})
+
+	// ✉️ Send the verification link (best effort, the user can request another)
+	if err := s.sendVerificationEmail(ctx, user); err != nil {
+		fmt.Println("⚠️ Failed to send verification email:", err)
 	}
-	tx.Commit()
 
 	return &auth.RegisterResponse{
-		UserId:  fmt.Sprintf("%d", user.ID),
+		UserId:  userID,
 		Message: "✅ User registered successfully",
 	}, nil
 }
 
+// ✅ Announce a new account through the outbox, in the transaction that creates it
+func publishUserRegistered(tx *gorm.DB, user models.User) error {
+	userID := fmt.Sprintf("%d", user.ID)
+	return outbox.Publish(tx, outbox.TopicUserRegistered, userID, outbox.UserRegistered{
+		UserID: userID,
+		Email:  user.Email,
+		Name:   user.Name,
+		Role:   user.Role,
+	})
+}
+
 // ✅ Login user with Redis caching & optimized error handling
 func (s *AuthServiceServer) Login(ctx context.Context, req *auth.LoginRequest) (*auth.LoginResponse, error) {
 	var user models.User
 
 	// ✅ Check Redis cache first
 	cachedUser, err := utils.GetCachedUser(req.Email)
-	if err == nil {
-		if bcrypt.CompareHashAndPassword([]byte(cachedUser.Password), []byte(req.Password)) == nil {
-			token, _ := utils.GenerateToken(req.Email, cachedUser.Role)
-			return &auth.LoginResponse{Token: token}, nil
+	if err == nil && cachedUser.ID != 0 {
+		if bcrypt.CompareHashAndPassword([]byte(cachedUser.Password), []byte(req.Password)) != nil {
+			recordLoginFailure(ctx, req.Email, cachedUser.ID, "invalid_password")
+			return nil, errInvalidCredentials
 		}
-		return nil, errors.New("❌ invalid credentials")
+		user = models.User{ID: cachedUser.ID, Email: req.Email, Role: cachedUser.Role, EmailVerified: cachedUser.EmailVerified}
+		return loginResponse(ctx, user, req.DeviceName)
 	}
 
 	// ✅ Fetch from DB if cache miss
-	if err := database.DB.Select("id, password, role").Where("email = ?", req.Email).First(&user).Error; err != nil {
+	if err := database.DB.Select("id, email, password, role, email_verified").Where("email = ?", req.Email).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("❌ user not found")
+			recordLoginFailure(ctx, req.Email, 0, "unknown_email")
+			return nil, errUserNotFound
 		}
-		return nil, errors.New("❌ database error")
+		return nil, errDatabase
 	}
 
 	// ✅ Validate password
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
-		return nil, errors.New("❌ invalid credentials")
+		recordLoginFailure(ctx, req.Email, user.ID, "invalid_password")
+		return nil, errInvalidCredentials
 	}
 
 	// ✅ Cache user in Redis
-	utils.CacheUser(req.Email, user.Password, user.Role)
+	utils.CacheUser(req.Email, utils.CachedUser{
+		ID:            user.ID,
+		Password:      user.Password,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerified,
+	})
+
+	return loginResponse(ctx, user, req.DeviceName)
+}
 
-	token, _ := utils.GenerateToken(req.Email, user.Role)
+// ✅ Password accepted: ask for the second factor or issue tokens
+func loginResponse(ctx context.Context, user models.User, deviceName string) (*auth.LoginResponse, error) {
+	if requireEmailVerification() && !user.EmailVerified {
+		return nil, errEmailNotVerified
+	}
 
-	// ✅ Prevent login if token is blacklisted
-	if utils.IsTokenBlacklisted(token) {
-		return nil, errors.New("❌ token is invalid or expired")
+	enrolled, err := hasSecondFactor(user.ID)
+	if err != nil {
+		return nil, errDatabase
+	}
+	if enrolled || secondFactorRequired(user.Role) {
+		challenge, err := createAccountToken(user.ID, models.TokenPurposeLoginChallenge, loginChallengeTTL)
+		if err != nil {
+			return nil, apperr.Internal("failed to create login challenge")
+		}
+		return &auth.LoginResponse{
+			SecondFactorRequired:           enrolled,
+			SecondFactorEnrollmentRequired: !enrolled,
+			ChallengeToken:                 challenge,
+		}, nil
 	}
 
-	return &auth.LoginResponse{Token: token}, nil
+	return completeLogin(ctx, user, deviceName)
 }
 
-// ✅ Logout user & blacklist token
-func (s *AuthServiceServer) Logout(ctx context.Context, req *auth.LogoutRequest) (*auth.LogoutResponse, error) {
-	err := utils.SaveTokenToBlacklist(req.Token, 24*60*60) // Blacklist token for 24 hours
+// ✅ Start a session and issue access + refresh tokens for a fully authenticated user
+func completeLogin(ctx context.Context, user models.User, deviceName string) (*auth.LoginResponse, error) {
+	pair, err := issueNewTokenPair(ctx, user, deviceName)
 	if err != nil {
-		return nil, errors.New("❌ failed to log out")
+		return nil, apperr.Internal("failed to issue tokens")
+	}
+
+	userID := fmt.Sprintf("%d", user.ID)
+	audit.Record(ctx, database.DB, audit.Event{
+		Type:     audit.LoginSucceeded,
+		ActorID:  userID,
+		TargetID: userID,
+		Details:  map[string]interface{}{"session_id": pair.record.FamilyID, "device_name": deviceName},
+	})
+
+	return &auth.LoginResponse{
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresIn:    pair.ExpiresIn,
+	}, nil
+}
+
+// ✅ Logout user: revoke the session of the access and/or refresh token
+func (s *AuthServiceServer) Logout(ctx context.Context, req *auth.LogoutRequest) (*auth.LogoutResponse, error) {
+	var sessionIDs []string
+	var userID string
+	if claims, err := utils.VerifyToken(req.Token); err == nil && claims.SessionID() != "" {
+		sessionIDs = append(sessionIDs, claims.SessionID())
+		userID = claims.UserID()
+	}
+	if req.RefreshToken != "" {
+		hash := utils.HashToken(req.RefreshToken)
+		if stored, err := findRefreshToken(hash); err == nil {
+			sessionIDs = append(sessionIDs, stored.FamilyID)
+			userID = fmt.Sprintf("%d", stored.UserID)
+		}
+		utils.DeleteCachedRefreshToken(hash)
 	}
+	if len(sessionIDs) == 0 && req.Token == "" {
+		return nil, apperr.InvalidField("token", "token or refresh_token is required")
+	}
+
+	for _, sessionID := range sessionIDs {
+		if err := revokeSession(sessionID); err != nil {
+			return nil, apperr.Internal("failed to log out")
+		}
+		audit.Record(ctx, database.DB, audit.Event{
+			Type:     audit.Logout,
+			ActorID:  userID,
+			TargetID: userID,
+			Details:  map[string]interface{}{"session_id": sessionID},
+		})
+	}
+
 	return &auth.LogoutResponse{Message: "✅ User logged out successfully"}, nil
 }
 
+// ✅ Audit a failed sign-in attempt (userID is 0 for unknown emails)
+func recordLoginFailure(ctx context.Context, email string, userID uint, reason string) {
+	event := audit.Event{
+		Type:    audit.LoginFailed,
+		Details: map[string]interface{}{"email": email, "reason": reason},
+	}
+	if userID != 0 {
+		event.TargetID = fmt.Sprintf("%d", userID)
+	}
+	audit.Record(ctx, database.DB, event)
+}
+
 // ✅ Fetch authenticated user details
 func (s *AuthServiceServer) GetAuthUser(ctx context.Context, req *auth.GetAuthUserRequest) (*auth.GetAuthUserResponse, error) {
 	var user models.User
 
-	if err := database.DB.Select("id, name, email, role").Where("id = ?", req.UserId).First(&user).Error; err != nil {
+	// ✅ Default to the caller when no user ID is given
+	userID := req.UserId
+	if userID == "" {
+		userID = authz.UserID(ctx)
+	}
+
+	if err := database.DB.Select("id, name, email, role, email_verified").Where("id = ?", userID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("❌ user not found")
+			return nil, errUserNotFound
 		}
-		return nil, errors.New("❌ database error")
+		return nil, errDatabase
 	}
 
 	return &auth.GetAuthUserResponse{
-		UserId: fmt.Sprintf("%d", user.ID),
-		Name:   user.Name,
-		Email:  user.Email,
-		Role:   user.Role,
+		UserId:        fmt.Sprintf("%d", user.ID),
+		Name:          user.Name,
+		Email:         user.Email,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerified,
 	}, nil
 }
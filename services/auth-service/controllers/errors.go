@@ -0,0 +1,38 @@
+package controllers
+
+import "github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+
+// Errors the RPCs return; clients switch on their reason (see shared-libs/apperr)
+var (
+	errDatabase               = apperr.Internal("database error")
+	errUserNotFound           = apperr.NotFound(apperr.ReasonUserNotFound, "user not found")
+	errEmailTaken             = apperr.AlreadyExists(apperr.ReasonEmailTaken, "email already in use")
+	errInvalidCredentials     = apperr.Unauthenticated(apperr.ReasonInvalidCredentials, "invalid credentials")
+	errEmailNotVerified       = apperr.FailedPrecondition(apperr.ReasonEmailNotVerified, "email address is not verified")
+	errAuthenticationRequired = apperr.Unauthenticated(apperr.ReasonAuthenticationRequired, "authentication required")
+	errInvalidPageToken       = apperr.InvalidArgument(apperr.ReasonInvalidPageToken, "invalid page token").WithField("page_token", "not a token from a previous page")
+
+	errInvalidRefreshToken = apperr.Unauthenticated(apperr.ReasonInvalidRefreshToken, "invalid refresh token")
+	errRefreshTokenRevoked = apperr.Unauthenticated(apperr.ReasonRefreshTokenRevoked, "refresh token has been revoked")
+	errRefreshTokenExpired = apperr.Unauthenticated(apperr.ReasonRefreshTokenExpired, "refresh token expired")
+	errRefreshTokenReused  = apperr.Unauthenticated(apperr.ReasonRefreshTokenReused, "refresh token reuse detected, please log in again")
+	errInvalidResetToken   = apperr.InvalidArgument(apperr.ReasonInvalidResetToken, "invalid or expired reset token")
+	errInvalidVerifyToken  = apperr.InvalidArgument(apperr.ReasonInvalidVerifyToken, "invalid or expired verification token")
+	errWeakPassword        = apperr.InvalidArgument(apperr.ReasonWeakPassword, "password must be at least 8 characters").WithField("new_password", "must be at least 8 characters")
+	errSessionNotFound     = apperr.NotFound(apperr.ReasonSessionNotFound, "session not found")
+
+	errSessionCheckUnavailable = apperr.Unavailable("session check unavailable")
+
+	errInvalidLoginChallenge   = apperr.Unauthenticated(apperr.ReasonInvalidLoginChallenge, "invalid or expired login challenge")
+	errInvalidSecondFactor     = apperr.Unauthenticated(apperr.ReasonInvalidSecondFactor, "invalid authentication code")
+	errTwoFactorNotEnabled     = apperr.FailedPrecondition(apperr.ReasonTwoFactorNotEnabled, "two-factor authentication is not enabled")
+	errTwoFactorAlreadyEnabled = apperr.FailedPrecondition(apperr.ReasonTwoFactorAlreadyEnabled, "two-factor authentication is already enabled")
+	errTwoFactorRequired       = apperr.FailedPrecondition(apperr.ReasonTwoFactorRequired, "two-factor authentication is mandatory for your role")
+	errNoPendingEnrollment     = apperr.FailedPrecondition(apperr.ReasonNoPendingEnrollment, "no pending two-factor enrollment")
+
+	errUnsupportedProvider      = apperr.InvalidArgument(apperr.ReasonUnsupportedProvider, "unsupported login provider").WithField("provider", "not a configured login provider")
+	errInvalidAuthorizationCode = apperr.Unauthenticated(apperr.ReasonInvalidProviderToken, "invalid authorization code")
+	errInvalidIDToken           = apperr.Unauthenticated(apperr.ReasonInvalidProviderToken, "invalid ID token")
+	errProviderEmailUnverified  = apperr.FailedPrecondition(apperr.ReasonProviderEmailUnverified, "your provider account has no verified email address")
+	errUnverifiedAccountExists  = apperr.FailedPrecondition(apperr.ReasonEmailNotVerified, "an account with this email exists but is not verified; verify it before signing in with a provider")
+)
@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/outbox"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/utils"
+)
+
+// EventConsumer is the name auth-service's replicas share on the outbox transport
+const EventConsumer = "auth-service"
+
+// ✅ Handle events other services publish through the outbox
+func SubscribeEvents(transport outbox.Transport) {
+	transport.Subscribe(EventConsumer, outbox.TopicUserDeleted, handleUserDeleted)
+	transport.Subscribe(EventConsumer, outbox.TopicUserUpdated, handleUserUpdated)
+}
+
+// ✅ Sign a deleted user out everywhere and drop their cached credentials
+func handleUserDeleted(ctx context.Context, message outbox.Message) error {
+	var event outbox.UserDeleted
+	if err := message.Decode(&event); err != nil {
+		fmt.Printf("⚠️ Dropping malformed %s message %d: %v\n", message.Topic, message.ID, err)
+		return nil
+	}
+
+	var userID uint
+	if _, err := fmt.Sscan(event.UserID, &userID); err != nil {
+		fmt.Printf("⚠️ Dropping %s message %d: invalid user ID %q\n", message.Topic, message.ID, event.UserID)
+		return nil
+	}
+
+	utils.DeleteCachedUser(event.Email)
+	_, err := revokeAllSessions(userID, "")
+	return err
+}
+
+// ✅ Drop the cached credentials of a user whose email or role changed, so the
+// old email stops logging in and new tokens carry the new role
+func handleUserUpdated(ctx context.Context, message outbox.Message) error {
+	var event outbox.UserUpdated
+	if err := message.Decode(&event); err != nil {
+		fmt.Printf("⚠️ Dropping malformed %s message %d: %v\n", message.Topic, message.ID, err)
+		return nil
+	}
+
+	utils.DeleteCachedUser(event.Email)
+	if event.NewEmail != "" {
+		utils.DeleteCachedUser(event.NewEmail)
+	}
+	return nil
+}
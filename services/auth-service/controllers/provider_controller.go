@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/oidc"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
+
+	"gorm.io/gorm"
+)
+
+// ✅ Sign in with an OpenID Connect provider, linking or creating the user by verified email
+func (s *AuthServiceServer) LoginWithProvider(ctx context.Context, req *auth.LoginWithProviderRequest) (*auth.LoginResponse, error) {
+	provider, ok := s.Providers[strings.ToLower(req.Provider)]
+	if !ok {
+		return nil, errUnsupportedProvider
+	}
+
+	idToken := req.IdToken
+	if idToken == "" {
+		if req.AuthorizationCode == "" {
+			return nil, apperr.InvalidField("authorization_code", "authorization_code or id_token is required")
+		}
+		token, err := provider.Exchange(ctx, req.AuthorizationCode, req.CodeVerifier)
+		if err != nil {
+			fmt.Println("⚠️ OIDC code exchange failed:", err)
+			return nil, errInvalidAuthorizationCode
+		}
+		idToken = token
+	}
+
+	claims, err := provider.VerifyIDToken(ctx, idToken, req.Nonce)
+	if err != nil {
+		fmt.Println("⚠️ OIDC ID token rejected:", err)
+		return nil, errInvalidIDToken
+	}
+
+	user, err := linkProviderAccount(provider.Name, claims)
+	if err != nil {
+		return nil, err
+	}
+	return loginResponse(ctx, user, req.DeviceName)
+}
+
+// ✅ Find the user for a provider identity: by link, else by email when that
+// account is verified (linking it), else create a new student
+func linkProviderAccount(provider string, claims *oidc.IDTokenClaims) (models.User, error) {
+	var user models.User
+
+	var linked models.LinkedAccount
+	err := database.DB.Where("provider = ? AND subject = ?", provider, claims.Subject).First(&linked).Error
+	if err == nil {
+		database.DB.Model(&linked).Updates(map[string]interface{}{"email": claims.Email, "last_login_at": time.Now()})
+		if err := database.DB.Select("id, email, role, email_verified").First(&user, linked.UserID).Error; err != nil {
+			return user, errUserNotFound
+		}
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, errDatabase
+	}
+
+	// Only an email the provider vouches for may claim an existing account
+	if !claims.Verified() {
+		return user, errProviderEmailUnverified
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Select("id, email, role, email_verified").Where("LOWER(email) = LOWER(?)", claims.Email).First(&user).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			user = models.User{
+				Name:          providerDisplayName(claims),
+				Email:         claims.Email,
+				Password:      "", // No password: sign in with the provider or reset the password
+				Role:          authz.RoleStudent,
+				EmailVerified: true,
+			}
+			if err = tx.Create(&user).Error; err == nil {
+				err = publishUserRegistered(tx, user)
+			}
+		} else if err == nil && !user.EmailVerified {
+			// Whoever registered the address never proved they own it and
+			// may still know its password, so linking would hand them the
+			// provider user's account
+			return errUnverifiedAccountExists
+		}
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&models.LinkedAccount{
+			UserID:      user.ID,
+			Provider:    provider,
+			Subject:     claims.Subject,
+			Email:       claims.Email,
+			LastLoginAt: time.Now(),
+		}).Error
+	})
+	if errors.Is(err, errUnverifiedAccountExists) {
+		return user, errUnverifiedAccountExists
+	}
+	if err != nil {
+		return user, apperr.Internal("failed to link account")
+	}
+
+	return user, nil
+}
+
+func providerDisplayName(claims *oidc.IDTokenClaims) string {
+	if claims.Name != "" {
+		return truncate(claims.Name, 255)
+	}
+	name, _, _ := strings.Cut(claims.Email, "@")
+	return name
+}
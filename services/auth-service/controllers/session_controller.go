@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/audit"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// ✅ List the caller's signed-in devices
+func (s *AuthServiceServer) ListSessions(ctx context.Context, req *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error) {
+	var sessions []models.Session
+	err := database.DB.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", authz.UserID(ctx), time.Now()).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, errDatabase
+	}
+
+	current := authz.SessionID(ctx)
+	response := &auth.ListSessionsResponse{Sessions: make([]*auth.Session, 0, len(sessions))}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, &auth.Session{
+			SessionId:  session.ID,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastSeenAt: timestamppb.New(session.LastSeenAt),
+			ExpiresAt:  timestamppb.New(session.ExpiresAt),
+			Current:    session.ID == current,
+		})
+	}
+	return response, nil
+}
+
+// ✅ Sign out one of the caller's devices
+func (s *AuthServiceServer) RevokeSession(ctx context.Context, req *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error) {
+	var session models.Session
+	err := database.DB.Select("id").
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", req.SessionId, authz.UserID(ctx)).
+		First(&session).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errSessionNotFound
+		}
+		return nil, errDatabase
+	}
+
+	if err := revokeSession(session.ID); err != nil {
+		return nil, apperr.Internal("failed to revoke session")
+	}
+	audit.Record(ctx, database.DB, audit.Event{
+		Type:     audit.SessionRevoked,
+		TargetID: authz.UserID(ctx),
+		Details:  map[string]interface{}{"session_id": session.ID},
+	})
+	return &auth.RevokeSessionResponse{Message: "✅ Session revoked"}, nil
+}
+
+// ✅ Sign out every device of the caller, optionally keeping this one
+func (s *AuthServiceServer) RevokeAllSessions(ctx context.Context, req *auth.RevokeAllSessionsRequest) (*auth.RevokeAllSessionsResponse, error) {
+	var userID uint
+	if _, err := fmt.Sscan(authz.UserID(ctx), &userID); err != nil {
+		return nil, errAuthenticationRequired
+	}
+
+	except := ""
+	if req.KeepCurrent {
+		except = authz.SessionID(ctx)
+	}
+	revoked, err := revokeAllSessions(userID, except)
+	if err != nil {
+		return nil, apperr.Internal("failed to revoke sessions")
+	}
+	audit.Record(ctx, database.DB, audit.Event{
+		Type:     audit.SessionRevoked,
+		TargetID: authz.UserID(ctx),
+		Details:  map[string]interface{}{"all": true, "kept_session_id": except, "revoked": revoked},
+	})
+	return &auth.RevokeAllSessionsResponse{
+		Message: "✅ Sessions revoked",
+		Revoked: int32(revoked),
+	}, nil
+}
+
+// ✅ Whether an access token's session was revoked. Postgres is the source of
+// truth (other services read the same table, see authz.WithSessionCheck);
+// unknown sessions count as revoked. A database error is returned as is, the
+// caller must not mistake it for a revocation.
+func IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	var count int64
+	err := database.DB.WithContext(ctx).Model(&models.Session{}).
+		Where("id = ? AND revoked_at IS NULL", sessionID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count == 0, nil
+}
+
+// ✅ Revoke a session and its refresh tokens
+func revokeSession(sessionID string) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Model(&models.Session{}).
+			Where("id = ? AND revoked_at IS NULL", sessionID).
+			Update("revoked_at", now).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&models.RefreshToken{}).
+			Where("family_id = ? AND revoked_at IS NULL", sessionID).
+			Update("revoked_at", now).Error
+	})
+}
+
+// ✅ Revoke every active session of a user except `except` (may be empty)
+func revokeAllSessions(userID uint, except string) (int, error) {
+	var sessionIDs []string
+	err := database.DB.Model(&models.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL AND id <> ?", userID, except).
+		Pluck("id", &sessionIDs).Error
+	if err != nil {
+		return 0, err
+	}
+
+	for _, sessionID := range sessionIDs {
+		if err := revokeSession(sessionID); err != nil {
+			return 0, err
+		}
+	}
+	return len(sessionIDs), nil
+}
+
+// truncate shortens client-supplied strings to their column size
+func truncate(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,231 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/utils"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
+
+	"gorm.io/gorm"
+)
+
+// tokenPair is an access token plus the refresh token that can renew it
+type tokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int64
+	record       models.RefreshToken
+}
+
+// ✅ Rotate refresh token: consume the presented token and issue a new pair in the same family
+func (s *AuthServiceServer) RefreshToken(ctx context.Context, req *auth.RefreshTokenRequest) (*auth.RefreshTokenResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, apperr.InvalidField("refresh_token", "refresh_token is required")
+	}
+
+	hash := utils.HashToken(req.RefreshToken)
+	stored, err := findRefreshToken(hash)
+	if err != nil {
+		return nil, errInvalidRefreshToken
+	}
+
+	revoked, err := IsSessionRevoked(ctx, stored.FamilyID)
+	if err != nil {
+		fmt.Println("⚠️ Failed to check the session of a refresh token:", err)
+		return nil, errSessionCheckUnavailable.Wrap(err)
+	}
+	if revoked {
+		return nil, errRefreshTokenRevoked
+	}
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, errRefreshTokenExpired
+	}
+
+	var user models.User
+	if err := database.DB.Select("id, email, role").Where("id = ?", stored.UserID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errUserNotFound
+		}
+		return nil, errDatabase
+	}
+
+	var pair *tokenPair
+	reused, revoked := false, false
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		var session models.Session
+		if err := tx.Where("id = ? AND revoked_at IS NULL", stored.FamilyID).First(&session).Error; err != nil {
+			revoked = errors.Is(err, gorm.ErrRecordNotFound)
+			return err
+		}
+
+		// ✅ Conditional update so only one replica can ever consume a given token
+		result := tx.Model(&models.RefreshToken{}).
+			Where("token_hash = ? AND used_at IS NULL AND revoked_at IS NULL", hash).
+			Update("used_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			reused = true
+			return errors.New("refresh token already used")
+		}
+
+		pair, err = issueTokenPair(ctx, tx, user, &session)
+		return err
+	})
+	utils.DeleteCachedRefreshToken(hash)
+	if err == nil {
+		pair.cache()
+	}
+
+	if reused {
+		// ⚠️ A consumed token was replayed: assume it leaked and kill the whole session
+		revokeSession(stored.FamilyID)
+		return nil, errRefreshTokenReused
+	}
+	if revoked {
+		return nil, errRefreshTokenRevoked
+	}
+	if err != nil {
+		return nil, apperr.Internal("failed to refresh token")
+	}
+
+	return &auth.RefreshTokenResponse{
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresIn:    pair.ExpiresIn,
+	}, nil
+}
+
+// ✅ Create an access token and a persisted refresh token for the session,
+// recording the new expiries and the client on it
+func issueTokenPair(ctx context.Context, tx *gorm.DB, user models.User, session *models.Session) (*tokenPair, error) {
+	userID := fmt.Sprintf("%d", user.ID)
+	accessToken, err := utils.GenerateToken(userID, user.Email, user.Role, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	record := models.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  session.ID,
+		TokenHash: utils.HashToken(refreshToken),
+		ExpiresAt: now.Add(utils.RefreshTokenTTL()),
+	}
+	if err := tx.Create(&record).Error; err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{
+		"last_seen_at":      now,
+		"expires_at":        record.ExpiresAt,
+		"access_expires_at": now.Add(utils.AccessTokenTTL()),
+		"ip_address":        utils.ClientIP(ctx),
+	}
+	if userAgent := utils.ClientUserAgent(ctx); userAgent != "" {
+		updates["user_agent"] = truncate(userAgent, 255)
+	}
+	if err := tx.Model(session).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return &tokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(utils.AccessTokenTTL().Seconds()),
+		record:       record,
+	}, nil
+}
+
+// ✅ Cache the refresh token once its row is committed
+func (p *tokenPair) cache() {
+	utils.CacheRefreshToken(p.record.TokenHash, utils.CachedRefreshToken{
+		UserID:    p.record.UserID,
+		FamilyID:  p.record.FamilyID,
+		ExpiresAt: p.record.ExpiresAt,
+	})
+}
+
+// ✅ Start a new session (one per login) with its first token pair
+func issueNewTokenPair(ctx context.Context, user models.User, deviceName string) (*tokenPair, error) {
+	sessionID, err := utils.GenerateTokenFamilyID()
+	if err != nil {
+		return nil, err
+	}
+
+	var pair *tokenPair
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		session := models.Session{
+			ID:              sessionID,
+			UserID:          user.ID,
+			DeviceName:      truncate(deviceName, 100),
+			LastSeenAt:      now,
+			ExpiresAt:       now.Add(utils.RefreshTokenTTL()),
+			AccessExpiresAt: now.Add(utils.AccessTokenTTL()),
+		}
+		if err := tx.Create(&session).Error; err != nil {
+			return err
+		}
+
+		pair, err = issueTokenPair(ctx, tx, user, &session)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pair.cache()
+	return pair, nil
+}
+
+// ✅ Look up refresh token metadata: Redis first, Postgres on cache miss
+func findRefreshToken(hash string) (*utils.CachedRefreshToken, error) {
+	if cached, err := utils.GetCachedRefreshToken(hash); err == nil {
+		return cached, nil
+	}
+
+	var record models.RefreshToken
+	if err := database.DB.Where("token_hash = ?", hash).First(&record).Error; err != nil {
+		return nil, err
+	}
+	if record.RevokedAt != nil {
+		return nil, errors.New("refresh token revoked")
+	}
+
+	return &utils.CachedRefreshToken{
+		UserID:    record.UserID,
+		FamilyID:  record.FamilyID,
+		ExpiresAt: record.ExpiresAt,
+	}, nil
+}
+
+// ✅ Publish verification keys so other services can validate access tokens
+func (s *AuthServiceServer) GetJWKS(ctx context.Context, req *auth.GetJWKSRequest) (*auth.GetJWKSResponse, error) {
+	keys := utils.PublicJWKS()
+	response := &auth.GetJWKSResponse{Keys: make([]*auth.JSONWebKey, 0, len(keys))}
+	for _, key := range keys {
+		response.Keys = append(response.Keys, &auth.JSONWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return response, nil
+}
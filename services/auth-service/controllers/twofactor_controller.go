@@ -0,0 +1,281 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/audit"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/utils"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
+
+	"gorm.io/gorm"
+)
+
+const (
+	totpIssuer           = "NeetChamp"
+	recoveryCodeCount    = 10
+	loginChallengeTTL    = 5 * time.Minute
+	maxChallengeAttempts = 5
+)
+
+// ✅ Complete a two-step login with a TOTP or recovery code
+func (s *AuthServiceServer) VerifySecondFactor(ctx context.Context, req *auth.VerifySecondFactorRequest) (*auth.LoginResponse, error) {
+	challenge, err := findAccountToken(database.DB, req.ChallengeToken, models.TokenPurposeLoginChallenge)
+	if err != nil {
+		return nil, errInvalidLoginChallenge
+	}
+
+	var twoFactor models.TwoFactor
+	if err := database.DB.Where("user_id = ? AND enabled = ?", challenge.UserID, true).First(&twoFactor).Error; err != nil {
+		return nil, errTwoFactorNotEnabled
+	}
+
+	if !verifySecondFactorCode(&twoFactor, req.Code) {
+		recordFailedChallenge(challenge)
+		audit.Record(ctx, database.DB, audit.Event{
+			Type:     audit.LoginFailed,
+			TargetID: fmt.Sprintf("%d", challenge.UserID),
+			Details:  map[string]interface{}{"reason": "invalid_second_factor"},
+		})
+		return nil, errInvalidSecondFactor
+	}
+
+	return finishChallenge(ctx, challenge, req.DeviceName)
+}
+
+// ✅ Start TOTP enrollment: returns a new secret and its provisioning URI
+func (s *AuthServiceServer) EnrollTOTP(ctx context.Context, req *auth.EnrollTOTPRequest) (*auth.EnrollTOTPResponse, error) {
+	user, _, err := secondFactorUser(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing models.TwoFactor
+	if err := database.DB.Where("user_id = ?", user.ID).First(&existing).Error; err == nil && existing.Enabled {
+		return nil, errTwoFactorAlreadyEnabled
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, apperr.Internal("failed to generate secret")
+	}
+	encrypted, err := utils.EncryptSecret(secret)
+	if err != nil {
+		return nil, apperr.Internal("failed to store secret")
+	}
+
+	// ✅ Replace any unconfirmed enrollment
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", user.ID).Delete(&models.TwoFactor{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.TwoFactor{UserID: user.ID, Secret: encrypted}).Error
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to start enrollment")
+	}
+
+	return &auth.EnrollTOTPResponse{
+		Secret:          secret,
+		ProvisioningUri: utils.TOTPProvisioningURI(totpIssuer, user.Email, secret),
+	}, nil
+}
+
+// ✅ Confirm enrollment with a first code, enabling 2FA and issuing recovery codes
+func (s *AuthServiceServer) ConfirmTOTP(ctx context.Context, req *auth.ConfirmTOTPRequest) (*auth.ConfirmTOTPResponse, error) {
+	user, challenge, err := secondFactorUser(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var twoFactor models.TwoFactor
+	if err := database.DB.Where("user_id = ? AND enabled = ?", user.ID, false).First(&twoFactor).Error; err != nil {
+		return nil, errNoPendingEnrollment
+	}
+
+	secret, err := utils.DecryptSecret(twoFactor.Secret)
+	if err != nil {
+		return nil, apperr.Internal("failed to read secret")
+	}
+	step, ok := utils.ValidateTOTP(secret, req.Code, 0, time.Now())
+	if !ok {
+		if challenge != nil {
+			recordFailedChallenge(challenge)
+		}
+		return nil, errInvalidSecondFactor
+	}
+
+	codes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, apperr.Internal("failed to generate recovery codes")
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Model(&twoFactor).Updates(map[string]interface{}{
+			"enabled":        true,
+			"enabled_at":     now,
+			"last_used_step": step,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return replaceRecoveryCodes(tx, user.ID, codes)
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to enable two-factor authentication")
+	}
+
+	userID := fmt.Sprintf("%d", user.ID)
+	audit.Record(ctx, database.DB, audit.Event{Type: audit.TwoFactorEnabled, ActorID: userID, TargetID: userID})
+
+	response := &auth.ConfirmTOTPResponse{RecoveryCodes: codes}
+	if challenge != nil {
+		login, err := finishChallenge(ctx, challenge, "")
+		if err != nil {
+			return nil, err
+		}
+		response.Login = login
+	}
+	return response, nil
+}
+
+// ✅ Turn off 2FA (not allowed for roles that require it)
+func (s *AuthServiceServer) DisableTOTP(ctx context.Context, req *auth.DisableTOTPRequest) (*auth.DisableTOTPResponse, error) {
+	if secondFactorRequired(authz.UserRole(ctx)) {
+		return nil, errTwoFactorRequired
+	}
+
+	var twoFactor models.TwoFactor
+	if err := database.DB.Where("user_id = ? AND enabled = ?", authz.UserID(ctx), true).First(&twoFactor).Error; err != nil {
+		return nil, errTwoFactorNotEnabled
+	}
+	if !verifySecondFactorCode(&twoFactor, req.Code) {
+		return nil, errInvalidSecondFactor
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", twoFactor.UserID).Delete(&models.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&twoFactor).Error
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to disable two-factor authentication")
+	}
+
+	audit.Record(ctx, database.DB, audit.Event{Type: audit.TwoFactorDisabled, TargetID: authz.UserID(ctx)})
+
+	return &auth.DisableTOTPResponse{Message: "✅ Two-factor authentication disabled"}, nil
+}
+
+// ✅ Resolve the user from the access token, or from a login challenge when
+// a role that requires 2FA has to enroll before its first login
+func secondFactorUser(ctx context.Context, challengeToken string) (models.User, *models.AccountToken, error) {
+	var user models.User
+	var challenge *models.AccountToken
+
+	userID := authz.UserID(ctx)
+	if challengeToken != "" {
+		record, err := findAccountToken(database.DB, challengeToken, models.TokenPurposeLoginChallenge)
+		if err != nil {
+			return user, nil, errInvalidLoginChallenge
+		}
+		challenge = record
+		userID = fmt.Sprintf("%d", record.UserID)
+	}
+	if userID == "" {
+		return user, nil, errAuthenticationRequired
+	}
+
+	if err := database.DB.Select("id, email, role").Where("id = ?", userID).First(&user).Error; err != nil {
+		return user, nil, errUserNotFound
+	}
+	return user, challenge, nil
+}
+
+// ✅ Consume the login challenge and issue tokens
+func finishChallenge(ctx context.Context, challenge *models.AccountToken, deviceName string) (*auth.LoginResponse, error) {
+	if err := markAccountTokenUsed(database.DB, challenge.ID); err != nil {
+		return nil, errInvalidLoginChallenge
+	}
+
+	var user models.User
+	if err := database.DB.Select("id, email, role").Where("id = ?", challenge.UserID).First(&user).Error; err != nil {
+		return nil, errUserNotFound
+	}
+	return completeLogin(ctx, user, deviceName)
+}
+
+// ✅ Count a wrong code; burn the challenge after too many
+func recordFailedChallenge(challenge *models.AccountToken) {
+	database.DB.Model(challenge).Update("attempts", gorm.Expr("attempts + 1"))
+	if challenge.Attempts+1 >= maxChallengeAttempts {
+		markAccountTokenUsed(database.DB, challenge.ID)
+	}
+}
+
+// ✅ Accept a fresh TOTP code (never the same step twice) or an unused recovery code
+func verifySecondFactorCode(twoFactor *models.TwoFactor, code string) bool {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return false
+	}
+
+	secret, err := utils.DecryptSecret(twoFactor.Secret)
+	if err != nil {
+		fmt.Println("⚠️ Failed to decrypt TOTP secret:", err)
+		return false
+	}
+	if step, ok := utils.ValidateTOTP(secret, code, twoFactor.LastUsedStep, time.Now()); ok {
+		// The condition also stops two concurrent logins using the same code
+		result := database.DB.Model(&models.TwoFactor{}).
+			Where("id = ? AND last_used_step < ?", twoFactor.ID, step).
+			Update("last_used_step", step)
+		return result.Error == nil && result.RowsAffected == 1
+	}
+
+	result := database.DB.Model(&models.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", twoFactor.UserID, utils.HashToken(utils.NormalizeRecoveryCode(code))).
+		Update("used_at", time.Now())
+	return result.Error == nil && result.RowsAffected == 1
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID uint, codes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&models.RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	records := make([]models.RecoveryCode, 0, len(codes))
+	for _, code := range codes {
+		records = append(records, models.RecoveryCode{UserID: userID, CodeHash: utils.HashToken(utils.NormalizeRecoveryCode(code))})
+	}
+	return tx.Create(&records).Error
+}
+
+// ✅ Whether the user has confirmed a TOTP enrollment
+func hasSecondFactor(userID uint) (bool, error) {
+	var count int64
+	err := database.DB.Model(&models.TwoFactor{}).Where("user_id = ? AND enabled = ?", userID, true).Count(&count).Error
+	return count > 0, err
+}
+
+// ✅ Roles that must use 2FA (REQUIRE_2FA_ROLES, comma separated, default "admin")
+func secondFactorRequired(role string) bool {
+	roles := os.Getenv("REQUIRE_2FA_ROLES")
+	if roles == "" {
+		roles = authz.RoleAdmin
+	}
+	for _, required := range strings.Split(roles, ",") {
+		if name := authz.RoleName(authz.ParseRole(required)); name != "" && name == authz.RoleName(authz.ParseRole(role)) {
+			return true
+		}
+	}
+	return false
+}
@@ -6,7 +6,9 @@ import (
 	"os"
 	"time"
 
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/audit"
 	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/outbox"
 	"github.com/joho/godotenv"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -45,7 +47,28 @@ func ConnectDatabase() {
 	database.Exec("CREATE INDEX IF NOT EXISTS idx_users_email ON users(email)")
 
 	// ✅ AutoMigrate with new fields
-	database.AutoMigrate(&models.User{})
+	database.AutoMigrate(&models.User{}, &models.RefreshToken{}, &models.AccountToken{}, &models.TwoFactor{}, &models.RecoveryCode{}, &models.Session{}, &models.LinkedAccount{})
+
+	// ✅ Append-only audit log
+	if err := audit.Migrate(database); err != nil {
+		log.Fatal("❌ Failed to migrate audit log:", err)
+	}
+
+	// ✅ Transactional outbox for events consumed by other services
+	if err := outbox.Migrate(database); err != nil {
+		log.Fatal("❌ Failed to migrate outbox:", err)
+	}
+
+	// ✅ Normalize legacy role names to the fixed role set
+	database.Exec("UPDATE users SET role = 'student' WHERE role IN ('mentee', '') OR role IS NULL")
+	database.Exec("UPDATE users SET role = 'content_editor' WHERE role = 'content-editor'")
+
+	// ✅ Give refresh token families issued before sessions existed a session row
+	database.Exec(`INSERT INTO sessions (id, user_id, created_at, last_seen_at, expires_at, access_expires_at)
+		SELECT family_id, user_id, MIN(created_at), MAX(created_at), MAX(expires_at), MAX(created_at)
+		FROM refresh_tokens WHERE revoked_at IS NULL
+		GROUP BY family_id, user_id
+		ON CONFLICT (id) DO NOTHING`)
 
 	DB = database
 	fmt.Println("✅ Database connected successfully!")
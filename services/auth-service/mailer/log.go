@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogSender prints messages to stdout and, when Dir is set, also writes each
+// one to an .eml file there. Meant for local development. Tokens are redacted
+// in the printed copy, since logs are shipped and kept; the links are in the
+// .eml files.
+type LogSender struct {
+	Dir  string
+	From string
+}
+
+// Token parameters, e.g. of the links in reset and verification emails
+var tokenParam = regexp.MustCompile(`(\btoken=)[^\s&]+`)
+
+func (s *LogSender) Send(ctx context.Context, msg Message) error {
+	fmt.Printf("📧 Email to %s: %s\n%s\n", msg.To, msg.Subject, redactTokens(msg.Body))
+	if s.Dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102-150405.000000000"), sanitize(msg.To))
+	return os.WriteFile(filepath.Join(s.Dir, name), formatMessage(s.From, msg), 0o644)
+}
+
+func redactTokens(body string) string {
+	return tokenParam.ReplaceAllString(body, "${1}[redacted]")
+}
+
+func sanitize(address string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '@' || r == '.' || r == '-' || r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, address)
+}
@@ -0,0 +1,75 @@
+package mailer
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRedactTokens(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{"Reset: https://neetchamp.app/reset-password?token=abc123\n", "Reset: https://neetchamp.app/reset-password?token=[redacted]\n"},
+		{"http://localhost:3000/verify-email?token=a%2Bb&lang=hi", "http://localhost:3000/verify-email?token=[redacted]&lang=hi"},
+		{"http://x/?lang=hi&token=abc more", "http://x/?lang=hi&token=[redacted] more"},
+		{"Bare token=abc too", "Bare token=[redacted] too"},
+	}
+	for _, tt := range tests {
+		if got := redactTokens(tt.body); got != tt.want {
+			t.Errorf("redactTokens(%q) = %q, want %q", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestLogSenderRedactsStdoutButKeepsOutboxFile(t *testing.T) {
+	dir := t.TempDir()
+	sender := &LogSender{Dir: dir, From: "NeetChamp <no-reply@neetchamp.app>"}
+	msg := Message{To: "student@example.com", Subject: "Reset your NeetChamp password", Body: "https://neetchamp.app/reset-password?token=secret-token\n"}
+
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	sendErr := sender.Send(context.Background(), msg)
+	w.Close()
+	os.Stdout = stdout
+	printed, _ := io.ReadAll(r)
+
+	if sendErr != nil {
+		t.Fatalf("Send() error = %v", sendErr)
+	}
+	if strings.Contains(string(printed), "secret-token") {
+		t.Errorf("token printed to stdout:\n%s", printed)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if len(files) != 1 {
+		t.Fatalf("wrote %d .eml files, want 1", len(files))
+	}
+	if eml, _ := os.ReadFile(files[0]); !strings.Contains(string(eml), "secret-token") {
+		t.Error(".eml file is missing the link")
+	}
+}
+
+func TestFromEnvRequiresDriver(t *testing.T) {
+	for _, driver := range []string{"", "sendgrid"} {
+		t.Setenv("MAIL_DRIVER", driver)
+		if _, err := FromEnv(); err == nil {
+			t.Errorf("FromEnv() with MAIL_DRIVER=%q succeeded", driver)
+		}
+	}
+
+	t.Setenv("MAIL_DRIVER", "log")
+	if sender, err := FromEnv(); err != nil {
+		t.Errorf("FromEnv() with MAIL_DRIVER=log: %v", err)
+	} else if _, ok := sender.(*LogSender); !ok {
+		t.Errorf("FromEnv() = %T, want *LogSender", sender)
+	}
+}
@@ -0,0 +1,50 @@
+// Package mailer sends transactional email (verification links, password resets)
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Message is a plain-text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers email messages
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// ✅ Build the sender selected by MAIL_DRIVER: "smtp", or "log" for local
+// development. There is no default, so a deployment that forgot to configure
+// SMTP fails to start instead of writing reset links to its logs.
+func FromEnv() (Sender, error) {
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		from = "NeetChamp <no-reply@neetchamp.app>"
+	}
+
+	switch driver := os.Getenv("MAIL_DRIVER"); driver {
+	case "smtp":
+		fmt.Println("✅ Sending email via SMTP at", os.Getenv("SMTP_HOST"))
+		return &SMTPSender{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     os.Getenv("SMTP_PORT"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     from,
+		}, nil
+	case "log":
+		fmt.Println("⚠️ MAIL_DRIVER=log, emails will be written to the log (development only)")
+		return &LogSender{Dir: os.Getenv("MAIL_OUTBOX_DIR"), From: from}, nil
+	case "":
+		return nil, errors.New(`MAIL_DRIVER is not set (use "smtp", or "log" for local development)`)
+	default:
+		return nil, fmt.Errorf(`unknown MAIL_DRIVER %q (use "smtp" or "log")`, driver)
+	}
+}
@@ -1,19 +1,91 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	"github.com/Aditya-PS-05/NeetChamp/auth-service/controllers"
 	"github.com/Aditya-PS-05/NeetChamp/auth-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/mailer"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/oidc"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/outbox"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/ratelimit"
 	"github.com/Aditya-PS-05/NeetChamp/auth-service/utils"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
 	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
+// RPCs callable without an access token
+var publicMethods = []string{
+	auth.AuthService_Register_FullMethodName,
+	auth.AuthService_Login_FullMethodName,
+	auth.AuthService_LoginWithProvider_FullMethodName,
+	auth.AuthService_Logout_FullMethodName,
+	auth.AuthService_RefreshToken_FullMethodName,
+	auth.AuthService_VerifySecondFactor_FullMethodName,
+	auth.AuthService_EnrollTOTP_FullMethodName,  // Also accepts a login challenge token
+	auth.AuthService_ConfirmTOTP_FullMethodName, // Also accepts a login challenge token
+	auth.AuthService_GetJWKS_FullMethodName,
+	auth.AuthService_RequestPasswordReset_FullMethodName,
+	auth.AuthService_ConfirmPasswordReset_FullMethodName,
+	auth.AuthService_SendVerificationEmail_FullMethodName,
+	auth.AuthService_VerifyEmail_FullMethodName,
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// ✅ Verify access tokens locally; WithSessionCheck rejects those of revoked sessions
+func verifyAccessToken(ctx context.Context, token string) (*authz.Claims, error) {
+	return utils.VerifyToken(token)
+}
+
+// ✅ Brute-force protection for the credential RPCs (see README for the env vars)
+func rateLimitRules() map[string]ratelimit.Rule {
+	return map[string]ratelimit.Rule{
+		auth.AuthService_Login_FullMethodName: {
+			Name:     "login",
+			PerIP:    ratelimit.LimitFromEnv("RATE_LIMIT_LOGIN_IP", ratelimit.Limit{Requests: 20, Window: time.Minute}),
+			PerEmail: ratelimit.LimitFromEnv("RATE_LIMIT_LOGIN_EMAIL", ratelimit.Limit{Requests: 10, Window: 15 * time.Minute}),
+			Global:   ratelimit.LimitFromEnv("RATE_LIMIT_LOGIN_GLOBAL", ratelimit.Limit{Requests: 3000, Window: time.Minute}),
+			Lockout: ratelimit.Lockout{
+				Threshold: utils.IntFromEnv("LOGIN_LOCKOUT_THRESHOLD", 5),
+				Base:      utils.DurationFromEnv("LOGIN_LOCKOUT_BASE", time.Minute),
+				Max:       utils.DurationFromEnv("LOGIN_LOCKOUT_MAX", time.Hour),
+				Window:    utils.DurationFromEnv("LOGIN_FAILURE_WINDOW", time.Hour),
+				Failures:  []apperr.Reason{apperr.ReasonInvalidCredentials, apperr.ReasonUserNotFound},
+			},
+		},
+		auth.AuthService_LoginWithProvider_FullMethodName: {
+			Name:   "login",
+			PerIP:  ratelimit.LimitFromEnv("RATE_LIMIT_LOGIN_IP", ratelimit.Limit{Requests: 20, Window: time.Minute}),
+			Global: ratelimit.LimitFromEnv("RATE_LIMIT_LOGIN_GLOBAL", ratelimit.Limit{Requests: 3000, Window: time.Minute}),
+		},
+		auth.AuthService_Register_FullMethodName: {
+			Name:   "registration",
+			PerIP:  ratelimit.LimitFromEnv("RATE_LIMIT_REGISTER_IP", ratelimit.Limit{Requests: 5, Window: time.Hour}),
+			Global: ratelimit.LimitFromEnv("RATE_LIMIT_REGISTER_GLOBAL", ratelimit.Limit{Requests: 600, Window: time.Minute}),
+		},
+		// Both send mail to any address they're given
+		auth.AuthService_RequestPasswordReset_FullMethodName: {
+			Name:     "email",
+			PerIP:    ratelimit.LimitFromEnv("RATE_LIMIT_ACCOUNT_EMAIL_IP", ratelimit.Limit{Requests: 10, Window: time.Hour}),
+			PerEmail: ratelimit.LimitFromEnv("RATE_LIMIT_ACCOUNT_EMAIL_EMAIL", ratelimit.Limit{Requests: 3, Window: time.Hour}),
+		},
+		auth.AuthService_SendVerificationEmail_FullMethodName: {
+			Name:     "email",
+			PerIP:    ratelimit.LimitFromEnv("RATE_LIMIT_ACCOUNT_EMAIL_IP", ratelimit.Limit{Requests: 10, Window: time.Hour}),
+			PerEmail: ratelimit.LimitFromEnv("RATE_LIMIT_ACCOUNT_EMAIL_EMAIL", ratelimit.Limit{Requests: 3, Window: time.Hour}),
+		},
+	}
+}
+
 func main() {
 	// 🏆 Connect to the database (optimized with connection pooling)
 	database.ConnectDatabase()
@@ -21,15 +93,52 @@ func main() {
 	// 🏆 Connect to Redis (efficient token storage)
 	utils.ConnectRedis()
 
+	// 🔑 Load JWT signing & verification keys
+	if err := utils.LoadSigningKeys(); err != nil {
+		log.Fatalf("❌ Failed to load JWT keys: %v", err)
+	}
+
+	// 📧 Email for password resets & verification links
+	mailSender, err := mailer.FromEnv()
+	if err != nil {
+		log.Fatalf("❌ Failed to set up email: %v", err)
+	}
+
+	// 🌐 Proxies (the grpc-gateway) whose x-forwarded-for names the client IP
+	if err := utils.LoadTrustedProxies(); err != nil {
+		log.Fatalf("❌ Failed to load trusted proxies: %v", err)
+	}
+
+	// 🚦 Rate limit logins, Register & account emails (Redis-backed, per-instance if Redis is down)
+	limiter := ratelimit.NewLimiter(utils.RedisClient, rateLimitRules())
+
 	listener, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("❌ Failed to listen: %v", err)
 	}
 
+	// 🔐 Require a valid access token on everything except the public auth RPCs
+	authInterceptor := authz.NewInterceptor(
+		authz.WithSessionCheck(authz.VerifierFunc(verifyAccessToken), authz.SessionStoreFunc(controllers.IsSessionRevoked)),
+		publicMethods...,
+	)
+	// 🛡️ Enforce the (authz.access) permissions declared in auth.proto
+	rbac := authz.NewEnforcer(nil)
+
 	grpcServer := grpc.NewServer(
 		grpc.MaxConcurrentStreams(2000), // Allow 200 parallel requests
+		grpc.ChainUnaryInterceptor(apperr.UnaryServerInterceptor(), limiter.Unary(), authInterceptor.Unary(), rbac.Unary()),
+		grpc.ChainStreamInterceptor(apperr.StreamServerInterceptor(), authInterceptor.Stream(), rbac.Stream()),
 	)
-	auth.RegisterAuthServiceServer(grpcServer, &controllers.AuthServiceServer{})
+	auth.RegisterAuthServiceServer(grpcServer, &controllers.AuthServiceServer{
+		Mailer:    mailSender,
+		Providers: oidc.ProvidersFromEnv(),
+	})
+
+	// 📬 Consume events from other services (e.g. sign deleted users out)
+	transport := outbox.NewPoller(database.DB)
+	controllers.SubscribeEvents(transport)
+	go transport.Run(context.Background())
 
 	// 🔹 Enable gRPC reflection
 	reflection.Register(grpcServer)
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+// Purposes of single-use account tokens
+const (
+	TokenPurposePasswordReset     = "password_reset"
+	TokenPurposeEmailVerification = "email_verification"
+	TokenPurposeLoginChallenge    = "login_challenge" // Password accepted, second factor pending
+)
+
+// AccountToken is a single-use, expiring token emailed to a user (password
+// reset, email verification). Only its SHA-256 hash is stored.
+type AccountToken struct {
+	ID        uint      `gorm:"primaryKey"`
+	UserID    uint      `gorm:"index;not null"`
+	Purpose   string    `gorm:"size:32;index;not null"`
+	TokenHash string    `gorm:"size:64;uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	Attempts  int       `gorm:"default:0"` // Failed attempts (login challenges)
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+// AuditEvent is one append-only entry of the security audit log (see audit.Record)
+type AuditEvent struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	Type      string    `gorm:"size:64;index;not null"`
+	ActorID   string    `gorm:"size:64;index"`
+	TargetID  string    `gorm:"size:64;index"`
+	IPAddress string    `gorm:"size:64"`
+	RequestID string    `gorm:"size:128"`
+	Details   string    `gorm:"type:jsonb;not null;default:'{}'"`
+	CreatedAt time.Time `gorm:"index;not null;default:CURRENT_TIMESTAMP"`
+}
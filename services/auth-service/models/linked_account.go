@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+// LinkedAccount is a provider identity (e.g. a Google account) that can sign in as a user
+type LinkedAccount struct {
+	ID          uint      `gorm:"primaryKey"`
+	UserID      uint      `gorm:"index;not null"`
+	Provider    string    `gorm:"size:50;not null;uniqueIndex:idx_linked_accounts_provider_subject"`
+	Subject     string    `gorm:"size:255;not null;uniqueIndex:idx_linked_accounts_provider_subject"` // The provider's `sub`
+	Email       string    `gorm:"size:255"`                                                           // Email at the provider when last used
+	CreatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	LastLoginAt time.Time
+	User        User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+// OutboxMessage is an event written in the same transaction as the change it
+// describes, delivered to consumers after commit (see outbox.Publish)
+type OutboxMessage struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	Topic     string    `gorm:"size:128;index;not null"`
+	Key       string    `gorm:"size:128"` // What the event is about, e.g. the user ID
+	Payload   string    `gorm:"type:jsonb;not null;default:'{}'"`
+	CreatedAt time.Time `gorm:"index;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// OutboxDelivery records that a consumer has handled a message
+type OutboxDelivery struct {
+	Consumer    string        `gorm:"primaryKey;size:64"`
+	MessageID   uint64        `gorm:"primaryKey"`
+	DeliveredAt time.Time     `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	Message     OutboxMessage `gorm:"foreignKey:MessageID;constraint:OnDelete:CASCADE"`
+}
+
+// OutboxSubscription records that a consumer handles a topic, so its messages
+// are kept until that consumer has them. Delete the rows of a retired consumer.
+type OutboxSubscription struct {
+	Consumer  string    `gorm:"primaryKey;size:64"`
+	Topic     string    `gorm:"primaryKey;size:128"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
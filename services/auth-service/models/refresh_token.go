@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+// RefreshToken stores the hash of an opaque refresh token. Tokens issued from
+// the same login share a FamilyID so a replayed token can revoke the whole chain.
+type RefreshToken struct {
+	ID        uint      `gorm:"primaryKey"`
+	UserID    uint      `gorm:"index;not null"`
+	FamilyID  string    `gorm:"size:64;index;not null"`
+	TokenHash string    `gorm:"size:64;uniqueIndex;not null"` // SHA-256 of the opaque token
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
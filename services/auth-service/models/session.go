@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+// Session is one signed-in device. Its ID is the `jti` of every access token
+// issued for it and the FamilyID of its refresh tokens.
+type Session struct {
+	ID              string    `gorm:"primaryKey;size:64"`
+	UserID          uint      `gorm:"index;not null"`
+	DeviceName      string    `gorm:"size:100;not null;default:''"`
+	UserAgent       string    `gorm:"size:255;not null;default:''"`
+	IPAddress       string    `gorm:"size:64;not null;default:''"`
+	CreatedAt       time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	LastSeenAt      time.Time `gorm:"not null"` // Last login or token refresh
+	ExpiresAt       time.Time `gorm:"not null"` // Expiry of the current refresh token
+	AccessExpiresAt time.Time `gorm:"not null"` // Expiry of the newest access token
+	RevokedAt       *time.Time
+	User            User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+)
+
+// TwoFactor holds a user's TOTP enrollment. It is pending until the first
+// code is confirmed (Enabled=false).
+type TwoFactor struct {
+	ID           uint   `gorm:"primaryKey"`
+	UserID       uint   `gorm:"uniqueIndex;not null"`
+	Secret       string `gorm:"not null"` // Encrypted with SECRETS_ENCRYPTION_KEY
+	Enabled      bool   `gorm:"default:false"`
+	LastUsedStep int64  `gorm:"default:0"` // Last accepted TOTP time step, blocks code replay
+	EnabledAt    *time.Time
+	CreatedAt    time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	User         User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// RecoveryCode is a hashed single-use backup code for when the authenticator is lost
+type RecoveryCode struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index;not null"`
+	CodeHash  string `gorm:"size:64;not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
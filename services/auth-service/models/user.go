@@ -2,14 +2,19 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type User struct {
-	ID        uint      `gorm:"primaryKey"`
-	Name      string    `gorm:"size:255;not null"`
-	Email     string    `gorm:"unique;not null"`
-	Password  string    `gorm:"not null"`
-	Role      string    `gorm:"default:'student'"`
-	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP"`
-	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"`
+	ID            uint           `gorm:"primaryKey"`
+	Name          string         `gorm:"size:255;not null"`
+	Email         string         `gorm:"unique;not null"`
+	Password      string         `gorm:"not null"`
+	Role          string         `gorm:"default:'student'"`
+	EmailVerified bool           `gorm:"default:false"`      // Set once the user follows the verification link
+	Version       uint64         `gorm:"not null;default:1"` // Bumped on every profile update; exposed as the etag
+	CreatedAt     time.Time      `gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time      `gorm:"default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"`
+	DeletedAt     gorm.DeletedAt `gorm:"index"` // Soft delete; user-service erases the account after the restore window
 }
@@ -0,0 +1,53 @@
+package oidc
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Providers are the configured identity providers by name
+type Providers map[string]*Provider
+
+// Well-known issuers, so only the client credentials need configuring
+var defaultIssuers = map[string]string{
+	"google": "https://accounts.google.com",
+}
+
+// ✅ Load providers from the environment
+//
+//	OIDC_PROVIDERS=google
+//	OIDC_GOOGLE_CLIENT_ID=...
+//	OIDC_GOOGLE_CLIENT_SECRET=...
+//	OIDC_GOOGLE_REDIRECT_URL=https://neetchamp.app/auth/callback/google
+//	OIDC_GOOGLE_ISSUER=http://localhost:8085   # Optional, e.g. a local mock server
+func ProvidersFromEnv() Providers {
+	providers := Providers{}
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		issuer := os.Getenv(prefix + "ISSUER")
+		if issuer == "" {
+			issuer = defaultIssuers[name]
+		}
+		clientID := os.Getenv(prefix + "CLIENT_ID")
+		if issuer == "" || clientID == "" {
+			fmt.Printf("⚠️ Skipping OIDC provider %q: %sISSUER and %sCLIENT_ID are required\n", name, prefix, prefix)
+			continue
+		}
+
+		providers[name] = &Provider{
+			Name:         name,
+			Issuer:       issuer,
+			ClientID:     clientID,
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+		}
+		fmt.Printf("✅ OIDC provider %s enabled (issuer %s)\n", name, issuer)
+	}
+	return providers
+}
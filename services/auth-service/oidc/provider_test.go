@@ -0,0 +1,220 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testClientID = "neetchamp-web"
+	testKeyID    = "provider-key"
+)
+
+// testIssuer is an OpenID provider serving discovery, JWKS and a token endpoint
+type testIssuer struct {
+	*httptest.Server
+	key ed25519.PrivateKey
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := authz.EncodePublicKey(testKeyID, jwt.SigningMethodEdDSA.Alg(), public)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := &testIssuer{key: private}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Discovery{
+			Issuer:        issuer.URL,
+			TokenEndpoint: issuer.URL + "/token",
+			JWKSURI:       issuer.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []authz.JSONWebKey{jwk}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "good-code" || r.FormValue("client_id") != testClientID || r.FormValue("code_verifier") != "verifier" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": issuer.sign(t, issuer.claims(nil))})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	return issuer
+}
+
+func (i *testIssuer) provider() *Provider {
+	return &Provider{Name: "test", Issuer: i.URL, ClientID: testClientID, HTTPClient: i.Client()}
+}
+
+// claims of a valid ID token, with overrides (a nil value removes the claim)
+func (i *testIssuer) claims(overrides map[string]interface{}) jwt.MapClaims {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":            i.URL,
+		"aud":            testClientID,
+		"sub":            "provider-user-1",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"email":          "student@example.com",
+		"email_verified": true,
+		"name":           "Asha Verma",
+		"nonce":          "n-0S6_WzA2Mj",
+	}
+	for key, value := range overrides {
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+	}
+	return claims
+}
+
+func (i *testIssuer) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString(i.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	past := time.Now().Add(-2 * time.Hour)
+
+	tests := []struct {
+		name      string
+		overrides map[string]interface{}
+		nonce     string
+		signWith  ed25519.PrivateKey
+		kid       string
+		wantErr   bool
+	}{
+		{name: "valid", nonce: "n-0S6_WzA2Mj"},
+		{name: "wrong audience", overrides: map[string]interface{}{"aud": "another-app"}, nonce: "n-0S6_WzA2Mj", wantErr: true},
+		{name: "one of several audiences, authorized for us", overrides: map[string]interface{}{"aud": []string{"another-app", testClientID}, "azp": testClientID}, nonce: "n-0S6_WzA2Mj"},
+		{name: "one of several audiences, authorized for another client", overrides: map[string]interface{}{"aud": []string{"another-app", testClientID}, "azp": "another-app"}, nonce: "n-0S6_WzA2Mj", wantErr: true},
+		{name: "wrong issuer", overrides: map[string]interface{}{"iss": "https://evil.example.com"}, nonce: "n-0S6_WzA2Mj", wantErr: true},
+		{name: "expired", overrides: map[string]interface{}{"exp": past.Unix(), "iat": past.Add(-time.Hour).Unix()}, nonce: "n-0S6_WzA2Mj", wantErr: true},
+		{name: "expired within leeway", overrides: map[string]interface{}{"exp": time.Now().Add(-30 * time.Second).Unix()}, nonce: "n-0S6_WzA2Mj"},
+		{name: "no expiry", overrides: map[string]interface{}{"exp": nil}, nonce: "n-0S6_WzA2Mj", wantErr: true},
+		{name: "no subject", overrides: map[string]interface{}{"sub": nil}, nonce: "n-0S6_WzA2Mj", wantErr: true},
+		{name: "signed with another key", nonce: "n-0S6_WzA2Mj", signWith: otherKey, wantErr: true},
+		{name: "unknown key ID", nonce: "n-0S6_WzA2Mj", kid: "rotated-away", wantErr: true},
+		{name: "nonce mismatch", nonce: "another-nonce", wantErr: true},
+		{name: "nonce sent, token has none", overrides: map[string]interface{}{"nonce": nil}, nonce: "n-0S6_WzA2Mj", wantErr: true},
+		{name: "token has a nonce, none sent", wantErr: true},
+		{name: "no nonce on either side", overrides: map[string]interface{}{"nonce": nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, issuer.claims(tt.overrides))
+			token.Header["kid"] = testKeyID
+			if tt.kid != "" {
+				token.Header["kid"] = tt.kid
+			}
+			key := issuer.key
+			if tt.signWith != nil {
+				key = tt.signWith
+			}
+			raw, err := token.SignedString(key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			claims, err := issuer.provider().VerifyIDToken(context.Background(), raw, tt.nonce)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("VerifyIDToken() accepted an invalid token")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyIDToken() error = %v", err)
+			}
+			if claims.Subject != "provider-user-1" || claims.Email != "student@example.com" || claims.Name != "Asha Verma" {
+				t.Errorf("VerifyIDToken() claims = %+v", claims)
+			}
+		})
+	}
+}
+
+// Linking a provider login to an account needs an email the provider vouches for
+func TestVerifyIDTokenEmailVerified(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	tests := []struct {
+		name      string
+		overrides map[string]interface{}
+		want      bool
+	}{
+		{"verified", nil, true},
+		{"verified as a string", map[string]interface{}{"email_verified": "true"}, true},
+		{"unverified", map[string]interface{}{"email_verified": false}, false},
+		{"unverified as a string", map[string]interface{}{"email_verified": "false"}, false},
+		{"no email_verified claim", map[string]interface{}{"email_verified": nil}, false},
+		{"verified but no email", map[string]interface{}{"email": nil}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := issuer.sign(t, issuer.claims(tt.overrides))
+			claims, err := issuer.provider().VerifyIDToken(context.Background(), raw, "n-0S6_WzA2Mj")
+			if err != nil {
+				t.Fatalf("VerifyIDToken() error = %v", err)
+			}
+			if got := claims.Verified(); got != tt.want {
+				t.Errorf("Verified() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiscoverRejectsForeignIssuer(t *testing.T) {
+	issuer := newTestIssuer(t)
+	provider := issuer.provider()
+	provider.Issuer = issuer.URL + "/" // Discovery names issuer.URL, which isn't the same string
+
+	if _, _, err := provider.Discover(context.Background()); err == nil {
+		t.Fatal("Discover() accepted a document for another issuer")
+	}
+}
+
+func TestExchange(t *testing.T) {
+	issuer := newTestIssuer(t)
+	provider := issuer.provider()
+
+	raw, err := provider.Exchange(context.Background(), "good-code", "verifier")
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if _, err := provider.VerifyIDToken(context.Background(), raw, "n-0S6_WzA2Mj"); err != nil {
+		t.Errorf("exchanged ID token rejected: %v", err)
+	}
+
+	if _, err := provider.Exchange(context.Background(), "bad-code", "verifier"); err == nil {
+		t.Error("Exchange() accepted a rejected code")
+	}
+}
@@ -0,0 +1,89 @@
+// Package outbox implements a transactional outbox: a service writes events to
+// the outbox_messages table in the same transaction as the change they
+// describe, and a Transport delivers them to subscribers once committed. It is
+// shared by auth-service (publisher) and user-service (consumer), which use
+// the same database.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+
+	"gorm.io/gorm"
+)
+
+// Topics
+const (
+	TopicUserRegistered = "user.registered"
+	TopicUserDeleted    = "user.deleted"
+	TopicUserUpdated    = "user.updated"
+)
+
+// UserRegistered is published when auth-service creates an account
+type UserRegistered struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	Role   string `json:"role"`
+}
+
+// UserDeleted is published when user-service soft-deletes an account
+type UserDeleted struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// UserUpdated is published when user-service changes the email or role of an
+// account. Email is the address before the change.
+type UserUpdated struct {
+	UserID   string `json:"user_id"`
+	Email    string `json:"email"`
+	NewEmail string `json:"new_email,omitempty"`
+	Role     string `json:"role"`
+}
+
+// Message is a published event as handed to subscribers
+type Message struct {
+	ID        uint64
+	Topic     string
+	Key       string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// Decode unmarshals the JSON payload
+func (m Message) Decode(v interface{}) error {
+	return json.Unmarshal(m.Payload, v)
+}
+
+// Handler processes one message. Delivery is at least once, so handlers must
+// be idempotent; returning an error has the message delivered again later.
+type Handler func(ctx context.Context, message Message) error
+
+// Transport delivers committed outbox messages to subscribers. The Poller
+// reads them straight from Postgres; a broker-backed transport would relay
+// them instead.
+type Transport interface {
+	// Subscribe registers a handler; consumer names a group of replicas that
+	// share the work, so each message is handled once per consumer
+	Subscribe(consumer, topic string, handler Handler)
+	// Run delivers messages until ctx is cancelled
+	Run(ctx context.Context) error
+}
+
+// ✅ Write an event to the outbox; pass the transaction of the change it describes
+func Publish(tx *gorm.DB, topic, key string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&models.OutboxMessage{Topic: topic, Key: key, Payload: string(data)}).Error
+}
+
+// ✅ Create the outbox tables
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&models.OutboxMessage{}, &models.OutboxDelivery{}, &models.OutboxSubscription{})
+}
@@ -0,0 +1,175 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Poller is a Transport that polls the outbox table, so it works without a
+// broker. Each delivery is claimed by inserting an outbox_deliveries row in a
+// transaction held open while the handler runs: replicas of a consumer skip
+// claimed messages, and a failed handler releases its claim so the message is
+// retried. Handlers write on their own connection, not in that transaction, so
+// a crash between their writes and the claim's commit redelivers the message;
+// that's why handlers must be idempotent.
+type Poller struct {
+	DB        *gorm.DB
+	Interval  time.Duration // Time between polls
+	BatchSize int           // Messages per topic per poll
+	Retention time.Duration // Older messages are purged once every subscribed consumer has handled them
+
+	subscriptions []subscription
+}
+
+type subscription struct {
+	consumer string
+	topic    string
+	handler  Handler
+}
+
+const purgeInterval = time.Hour
+
+// ✅ Create a Poller with default settings (1s interval, batches of 100, 7 days retention)
+func NewPoller(db *gorm.DB) *Poller {
+	return &Poller{
+		DB:        db,
+		Interval:  time.Second,
+		BatchSize: 100,
+		Retention: 7 * 24 * time.Hour,
+	}
+}
+
+// Subscribe implements Transport; call it before Run
+func (p *Poller) Subscribe(consumer, topic string, handler Handler) {
+	p.subscriptions = append(p.subscriptions, subscription{consumer: consumer, topic: topic, handler: handler})
+}
+
+// Run implements Transport
+func (p *Poller) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	var lastPurge time.Time
+	for {
+		for _, sub := range p.subscriptions {
+			p.poll(ctx, sub)
+		}
+		if time.Since(lastPurge) > purgeInterval {
+			p.register(ctx)
+			p.purge(ctx)
+			lastPurge = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll delivers the oldest messages the consumer hasn't handled yet
+func (p *Poller) poll(ctx context.Context, sub subscription) {
+	var messages []models.OutboxMessage
+	err := p.DB.WithContext(ctx).
+		Where("topic = ?", sub.topic).
+		Where("NOT EXISTS (SELECT 1 FROM outbox_deliveries d WHERE d.consumer = ? AND d.message_id = outbox_messages.id)", sub.consumer).
+		Order("id").
+		Limit(p.BatchSize).
+		Find(&messages).Error
+	if err != nil {
+		if ctx.Err() == nil {
+			fmt.Printf("⚠️ Outbox poll for %s failed: %v\n", sub.consumer, err)
+		}
+		return
+	}
+
+	for _, message := range messages {
+		if err := p.deliver(ctx, sub, message); err != nil {
+			fmt.Printf("⚠️ Outbox consumer %s failed on message %d (%s), will retry: %v\n", sub.consumer, message.ID, message.Topic, err)
+		}
+	}
+}
+
+func (p *Poller) deliver(ctx context.Context, sub subscription, message models.OutboxMessage) error {
+	return p.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Blocks while another replica holds the claim, then finds it taken
+		claim := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.OutboxDelivery{Consumer: sub.consumer, MessageID: message.ID})
+		if claim.Error != nil {
+			return claim.Error
+		}
+		if claim.RowsAffected == 0 {
+			return nil
+		}
+
+		return sub.handler(ctx, Message{
+			ID:        message.ID,
+			Topic:     message.Topic,
+			Key:       message.Key,
+			Payload:   []byte(message.Payload),
+			CreatedAt: message.CreatedAt,
+		})
+	})
+}
+
+// register records the subscriptions, so no poller purges messages these
+// consumers haven't handled yet
+func (p *Poller) register(ctx context.Context) {
+	for _, sub := range p.subscriptions {
+		err := p.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.OutboxSubscription{Consumer: sub.consumer, Topic: sub.topic}).Error
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("⚠️ Failed to register outbox subscription %s/%s: %v\n", sub.consumer, sub.topic, err)
+		}
+	}
+}
+
+// Messages none of the topic's subscribers is still waiting for
+const allDelivered = `NOT EXISTS (SELECT 1 FROM outbox_subscriptions s WHERE s.topic = outbox_messages.topic
+	AND NOT EXISTS (SELECT 1 FROM outbox_deliveries d WHERE d.consumer = s.consumer AND d.message_id = outbox_messages.id))`
+
+// purge drops messages past retention that every subscribed consumer has
+// handled; their deliveries cascade. Undelivered ones are kept and reported,
+// e.g. for a consumer that has been down or was retired without removing its
+// outbox_subscriptions rows.
+func (p *Poller) purge(ctx context.Context) {
+	cutoff := time.Now().Add(-p.Retention)
+	err := p.DB.WithContext(ctx).
+		Where("created_at < ?", cutoff).
+		Where(allDelivered).
+		Delete(&models.OutboxMessage{}).Error
+	if err != nil {
+		if ctx.Err() == nil {
+			fmt.Println("⚠️ Failed to purge outbox:", err)
+		}
+		return
+	}
+
+	var pending []struct {
+		Consumer string
+		Count    int64
+	}
+	err = p.DB.WithContext(ctx).Table("outbox_messages").
+		Select("s.consumer, COUNT(*) AS count").
+		Joins("JOIN outbox_subscriptions s ON s.topic = outbox_messages.topic").
+		Where("outbox_messages.created_at < ?", cutoff).
+		Where("NOT EXISTS (SELECT 1 FROM outbox_deliveries d WHERE d.consumer = s.consumer AND d.message_id = outbox_messages.id)").
+		Group("s.consumer").
+		Scan(&pending).Error
+	if err != nil {
+		if ctx.Err() == nil {
+			fmt.Println("⚠️ Failed to count undelivered outbox messages:", err)
+		}
+		return
+	}
+	for _, consumer := range pending {
+		fmt.Printf("⚠️ Keeping %d outbox messages past retention that %s hasn't handled yet\n", consumer.Count, consumer.Consumer)
+	}
+}
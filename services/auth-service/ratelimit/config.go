@@ -0,0 +1,34 @@
+package ratelimit
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ✅ Read a limit such as "20/1m" (20 requests per minute) from the environment.
+// "0" or "off" disables it; invalid values keep the default.
+func LimitFromEnv(key string, def Limit) Limit {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return def
+	}
+	if value == "0" || value == "off" {
+		return Limit{}
+	}
+
+	count, window, ok := strings.Cut(value, "/")
+	requests, err := strconv.Atoi(count)
+	if !ok || err != nil || requests < 0 {
+		fmt.Printf("⚠️ Invalid %s=%q (expected e.g. 20/1m), using default\n", key, value)
+		return def
+	}
+	duration, err := time.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		fmt.Printf("⚠️ Invalid %s=%q (expected e.g. 20/1m), using default\n", key, value)
+		return def
+	}
+	return Limit{Requests: requests, Window: duration}
+}
@@ -0,0 +1,257 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/utils"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const keyPrefix = "ratelimit:"
+
+// Limit allows `Requests` calls per sliding `Window`; the zero Limit is unlimited
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+func (l Limit) enabled() bool {
+	return l.Requests > 0 && l.Window > 0
+}
+
+// Lockout blocks an email after `Threshold` consecutive failures, for `Base`
+// and doubling on every further failure up to `Max`. Only errors with one of
+// the `Failures` reasons count, so outages and other refusals don't lock
+// anyone out. Failures are forgotten `Window` after the last one, or as soon
+// as a call returns a token.
+type Lockout struct {
+	Threshold int
+	Base      time.Duration
+	Max       time.Duration
+	Window    time.Duration
+	Failures  []apperr.Reason
+}
+
+func (l Lockout) enabled() bool {
+	return l.Threshold > 0 && l.Base > 0
+}
+
+// failed reports whether err is a failed attempt, e.g. a wrong password
+func (l Lockout) failed(err error) bool {
+	if err == nil {
+		return false
+	}
+	reason := apperr.FromError(err).Reason
+	for _, r := range l.Failures {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// duration of the lockout triggered by the n-th consecutive failure
+func (l Lockout) duration(failures int64) time.Duration {
+	shift := failures - int64(l.Threshold)
+	if shift > 30 {
+		shift = 30
+	}
+	d := l.Base * time.Duration(int64(1)<<shift)
+	if l.Max > 0 && (d > l.Max || d <= 0) {
+		d = l.Max
+	}
+	return d
+}
+
+// Rule configures the limits of one RPC
+type Rule struct {
+	Name     string // Used in error messages, e.g. "login"
+	PerIP    Limit
+	PerEmail Limit // Needs a request with GetEmail()
+	Global   Limit
+	Lockout  Lockout
+}
+
+// Limiter is a gRPC interceptor applying Rules by full method name
+type Limiter struct {
+	rules    map[string]Rule
+	store    Store
+	fallback *MemoryStore
+}
+
+// ✅ Create a limiter backed by Redis, or by process memory when Redis is unavailable
+func NewLimiter(client *redis.Client, rules map[string]Rule) *Limiter {
+	l := &Limiter{
+		rules:    rules,
+		fallback: NewMemoryStore(),
+	}
+	if client != nil {
+		l.store = &RedisStore{Client: client}
+	} else {
+		fmt.Println("⚠️ Redis is not connected, rate limits are enforced per instance only")
+		l.store = l.fallback
+	}
+	return l
+}
+
+// ✅ Unary interceptor
+func (l *Limiter) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		rule, ok := l.rules[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		email := requestEmail(req)
+		if err := l.check(ctx, info.FullMethod, rule, email); err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		if rule.Lockout.enabled() && email != "" {
+			switch {
+			case rule.Lockout.failed(err):
+				l.recordFailure(ctx, info.FullMethod, rule, email)
+			case err == nil && issuedToken(resp):
+				l.reset(ctx, info.FullMethod, email)
+			}
+		}
+		return resp, err
+	}
+}
+
+type window struct {
+	limit Limit
+	key   string
+	scope string
+}
+
+// check enforces the lockout and every sliding window of the rule
+func (l *Limiter) check(ctx context.Context, method string, rule Rule, email string) error {
+	if rule.Lockout.enabled() && email != "" {
+		remaining, err := l.lockedFor(ctx, lockKey(method, email))
+		if err == nil && remaining > 0 {
+			return exhausted(ctx, apperr.ReasonAccountLocked, remaining, fmt.Sprintf("too many failed %s attempts for this account", rule.Name))
+		}
+	}
+
+	windows := []window{
+		{rule.Global, keyPrefix + method + ":global", "requests"},
+		{rule.PerIP, keyPrefix + method + ":ip:" + utils.ClientIP(ctx), "requests from your network"},
+	}
+	if email != "" {
+		windows = append(windows, window{rule.PerEmail, keyPrefix + method + ":email:" + hashEmail(email), "attempts for this account"})
+	}
+
+	for _, w := range windows {
+		if !w.limit.enabled() {
+			continue
+		}
+		allowed, wait, err := l.allow(ctx, w.key, w.limit)
+		if err != nil {
+			return apperr.Unavailable("rate limiter unavailable").Wrap(err)
+		}
+		if !allowed {
+			return exhausted(ctx, apperr.ReasonRateLimited, wait, fmt.Sprintf("too many %s %s", rule.Name, w.scope))
+		}
+	}
+	return nil
+}
+
+func (l *Limiter) recordFailure(ctx context.Context, method string, rule Rule, email string) {
+	forgetAfter := rule.Lockout.Window
+	if forgetAfter <= 0 {
+		forgetAfter = time.Hour
+	}
+
+	failures, err := l.store.RecordFailure(ctx, failureKey(method, email), forgetAfter)
+	if err != nil {
+		failures, _ = l.fallback.RecordFailure(ctx, failureKey(method, email), forgetAfter)
+	}
+	if failures < int64(rule.Lockout.Threshold) {
+		return
+	}
+
+	duration := rule.Lockout.duration(failures)
+	if err := l.store.Lock(ctx, lockKey(method, email), duration); err != nil {
+		l.fallback.Lock(ctx, lockKey(method, email), duration)
+	}
+}
+
+func (l *Limiter) reset(ctx context.Context, method, email string) {
+	keys := []string{failureKey(method, email), lockKey(method, email)}
+	if err := l.store.Reset(ctx, keys...); err != nil {
+		fmt.Println("⚠️ Failed to reset login failures:", err)
+	}
+	l.fallback.Reset(ctx, keys...)
+}
+
+// allow falls back to the in-memory store if Redis fails mid-flight
+func (l *Limiter) allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	allowed, wait, err := l.store.Allow(ctx, key, limit.Requests, limit.Window)
+	if err != nil && l.store != Store(l.fallback) {
+		fmt.Println("⚠️ Redis rate limit check failed, using in-memory limits:", err)
+		return l.fallback.Allow(ctx, key, limit.Requests, limit.Window)
+	}
+	return allowed, wait, err
+}
+
+func (l *Limiter) lockedFor(ctx context.Context, key string) (time.Duration, error) {
+	remaining, err := l.store.LockedFor(ctx, key)
+	if err != nil {
+		return l.fallback.LockedFor(ctx, key)
+	}
+	if fallback, _ := l.fallback.LockedFor(ctx, key); fallback > remaining {
+		return fallback, nil
+	}
+	return remaining, nil
+}
+
+// exhausted builds a ResourceExhausted error carrying `retry-after` (seconds)
+// in the response headers and a RetryInfo detail
+func exhausted(ctx context.Context, reason apperr.Reason, wait time.Duration, message string) error {
+	seconds := int64(math.Ceil(wait.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.FormatInt(seconds, 10)))
+
+	return apperr.ResourceExhausted(reason, fmt.Sprintf("%s, try again in %ds", message, seconds), time.Duration(seconds)*time.Second)
+}
+
+func requestEmail(req interface{}) string {
+	if r, ok := req.(interface{ GetEmail() string }); ok {
+		return strings.ToLower(strings.TrimSpace(r.GetEmail()))
+	}
+	return ""
+}
+
+// issuedToken tells a completed login from e.g. a second factor challenge
+func issuedToken(resp interface{}) bool {
+	r, ok := resp.(interface{ GetToken() string })
+	return ok && r.GetToken() != ""
+}
+
+// Emails are hashed so Redis keys don't hold personal data
+func hashEmail(email string) string {
+	sum := sha256.Sum256([]byte(email))
+	return hex.EncodeToString(sum[:16])
+}
+
+func failureKey(method, email string) string {
+	return keyPrefix + method + ":failures:" + hashEmail(email)
+}
+
+func lockKey(method, email string) string {
+	return keyPrefix + method + ":locked:" + hashEmail(email)
+}
@@ -0,0 +1,181 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const testMethod = "/auth.AuthService/Login"
+
+type loginRequest struct{ email string }
+
+func (r loginRequest) GetEmail() string { return r.email }
+
+type loginResponse struct{ token string }
+
+func (r loginResponse) GetToken() string { return r.token }
+
+var testLockout = Lockout{
+	Threshold: 3,
+	Base:      time.Minute,
+	Max:       10 * time.Minute,
+	Window:    time.Hour,
+	Failures:  []apperr.Reason{apperr.ReasonInvalidCredentials, apperr.ReasonUserNotFound},
+}
+
+// newTestLimiter builds a limiter without Redis, i.e. on the in-memory store
+func newTestLimiter(rule Rule) (*Limiter, *fakeClock) {
+	limiter := NewLimiter(nil, map[string]Rule{testMethod: rule})
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter.fallback.now = clock.Now
+	return limiter, clock
+}
+
+// call runs the interceptor in front of a handler returning resp, err
+func call(limiter *Limiter, email string, resp interface{}, err error) (handled bool, _ error) {
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	_, callErr := limiter.Unary()(context.Background(), loginRequest{email: email}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = true
+		return resp, err
+	})
+	return handled, callErr
+}
+
+func TestLockoutDuration(t *testing.T) {
+	tests := []struct {
+		failures int64
+		want     time.Duration
+	}{
+		{3, time.Minute},
+		{4, 2 * time.Minute},
+		{5, 4 * time.Minute},
+		{6, 8 * time.Minute},
+		{7, 10 * time.Minute}, // Capped at Max
+		{100, 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := testLockout.duration(tt.failures); got != tt.want {
+			t.Errorf("duration(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestNewLimiterWithoutRedisUsesMemory(t *testing.T) {
+	limiter := NewLimiter(nil, nil)
+	if limiter.store != Store(limiter.fallback) {
+		t.Fatalf("store = %T, want the in-memory fallback", limiter.store)
+	}
+}
+
+func TestLimiterWindow(t *testing.T) {
+	limiter, clock := newTestLimiter(Rule{Name: "login", PerEmail: Limit{Requests: 2, Window: time.Minute}})
+	failed := apperr.Unauthenticated(apperr.ReasonInvalidCredentials, "invalid credentials")
+
+	for i := 0; i < 2; i++ {
+		if handled, _ := call(limiter, "a@example.com", nil, failed); !handled {
+			t.Fatalf("call %d refused within the limit", i+1)
+		}
+	}
+	handled, err := call(limiter, "a@example.com", nil, failed)
+	if handled || apperr.FromError(err).Reason != apperr.ReasonRateLimited {
+		t.Fatalf("call over the limit: handled = %v, err = %v, want RATE_LIMITED", handled, err)
+	}
+	if handled, _ := call(limiter, "b@example.com", nil, failed); !handled {
+		t.Error("another email was limited too")
+	}
+
+	clock.Advance(time.Minute)
+	if handled, _ := call(limiter, "a@example.com", nil, failed); !handled {
+		t.Error("still limited after the window")
+	}
+}
+
+func TestLimiterLockoutSchedule(t *testing.T) {
+	limiter, clock := newTestLimiter(Rule{Name: "login", Lockout: testLockout})
+	failed := apperr.Unauthenticated(apperr.ReasonInvalidCredentials, "invalid credentials")
+	const email = "student@example.com"
+
+	// Two failures are free, the third locks for Base
+	for i := 0; i < 2; i++ {
+		call(limiter, email, nil, failed)
+	}
+	if handled, _ := call(limiter, email, nil, failed); !handled {
+		t.Fatal("third attempt refused before the threshold was reached")
+	}
+
+	// Every further failure doubles the lockout
+	for _, want := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute} {
+		handled, err := call(limiter, email, nil, failed)
+		if handled {
+			t.Fatalf("attempt during a %v lockout reached the handler", want)
+		}
+		if e := apperr.FromError(err); e.Code != codes.ResourceExhausted || e.Reason != apperr.ReasonAccountLocked {
+			t.Fatalf("locked attempt: err = %v, want ACCOUNT_LOCKED", err)
+		}
+		if remaining, _ := limiter.lockedFor(context.Background(), lockKey(testMethod, email)); remaining != want {
+			t.Fatalf("lockout = %v, want %v", remaining, want)
+		}
+
+		clock.Advance(want)
+		if handled, _ := call(limiter, email, nil, failed); !handled {
+			t.Fatalf("attempt after a %v lockout refused", want)
+		}
+	}
+
+	// A login that returns a token clears the failures
+	clock.Advance(8 * time.Minute)
+	if handled, err := call(limiter, email, loginResponse{token: "access"}, nil); !handled || err != nil {
+		t.Fatalf("successful login: handled = %v, err = %v", handled, err)
+	}
+	for i := 0; i < 2; i++ {
+		call(limiter, email, nil, failed)
+	}
+	if handled, _ := call(limiter, email, nil, failed); !handled {
+		t.Error("failures before a successful login still counted")
+	}
+}
+
+func TestLimiterOnlyCredentialFailuresCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		resp   interface{}
+		err    error
+		counts bool
+	}{
+		{"wrong password", nil, apperr.Unauthenticated(apperr.ReasonInvalidCredentials, "invalid credentials"), true},
+		{"unknown email", nil, apperr.NotFound(apperr.ReasonUserNotFound, "user not found"), true},
+		{"unverified email", nil, apperr.FailedPrecondition(apperr.ReasonEmailNotVerified, "email address is not verified"), false},
+		{"database outage", nil, apperr.Internal("database error"), false},
+		{"unavailable", nil, apperr.Unavailable("redis down"), false},
+		{"plain error", nil, errors.New("boom"), false},
+		{"second factor challenge", loginResponse{}, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, _ := newTestLimiter(Rule{Name: "login", Lockout: testLockout})
+			for i := 0; i < testLockout.Threshold; i++ {
+				call(limiter, "student@example.com", tt.resp, tt.err)
+			}
+			handled, _ := call(limiter, "student@example.com", tt.resp, tt.err)
+			if locked := !handled; locked != tt.counts {
+				t.Errorf("locked after %d attempts = %v, want %v", testLockout.Threshold, locked, tt.counts)
+			}
+		})
+	}
+}
+
+func TestLimiterWithoutEmailSkipsLockout(t *testing.T) {
+	limiter, _ := newTestLimiter(Rule{Name: "login", Lockout: testLockout})
+	failed := apperr.Unauthenticated(apperr.ReasonInvalidCredentials, "invalid credentials")
+	for i := 0; i < testLockout.Threshold+1; i++ {
+		if handled, _ := call(limiter, "", nil, failed); !handled {
+			t.Fatalf("attempt %d without an email was locked", i+1)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Store keeps sliding windows, failure counters and lockouts
+type Store interface {
+	// Allow records a hit in the sliding window unless `limit` hits already
+	// happened within `window`; when refused it returns how long to wait
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error)
+	// RecordFailure counts a failure, forgetting them `window` after the last one
+	RecordFailure(ctx context.Context, key string, window time.Duration) (int64, error)
+	// Lock blocks `key` for `duration`
+	Lock(ctx context.Context, key string, duration time.Duration) error
+	// LockedFor returns the remaining lockout (0 if not locked)
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+	// Reset clears failure counters & lockouts
+	Reset(ctx context.Context, keys ...string) error
+}
+
+// ✅ Sliding window log: drop expired hits, count, add the hit only if under the limit
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+if redis.call('ZCARD', key) < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	redis.call('PEXPIRE', key, window)
+	return 0
+end
+
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+return tonumber(oldest[2]) + window - now
+`)
+
+// RedisStore shares limits across every auth-service replica
+type RedisStore struct {
+	Client *redis.Client
+
+	mu  sync.Mutex
+	seq uint64
+}
+
+func (s *RedisStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	now := time.Now()
+	wait, err := slidingWindowScript.Run(ctx, s.Client, []string{key},
+		now.UnixMilli(), window.Milliseconds(), limit, s.member(now)).Int64()
+	if err != nil {
+		return false, 0, err
+	}
+	return wait == 0, time.Duration(wait) * time.Millisecond, nil
+}
+
+func (s *RedisStore) RecordFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipe := s.Client.TxPipeline()
+	count := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return count.Val(), nil
+}
+
+func (s *RedisStore) Lock(ctx context.Context, key string, duration time.Duration) error {
+	return s.Client.Set(ctx, key, "locked", duration).Err()
+}
+
+func (s *RedisStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := s.Client.PTTL(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 { // -2: no lock, -1: no expiry (never set by us)
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (s *RedisStore) Reset(ctx context.Context, keys ...string) error {
+	return s.Client.Del(ctx, keys...).Err()
+}
+
+// member makes every hit unique within the sorted set, even within the same millisecond
+func (s *RedisStore) member(now time.Time) string {
+	s.mu.Lock()
+	s.seq++
+	seq := s.seq
+	s.mu.Unlock()
+	return fmt.Sprintf("%d-%s", now.UnixNano(), strconv.FormatUint(seq, 36))
+}
+
+// MemoryStore limits per replica; used when Redis is unavailable
+type MemoryStore struct {
+	mu       sync.Mutex
+	windows  map[string]*memoryWindow
+	failures map[string]memoryCounter
+	locks    map[string]time.Time
+	sweptAt  time.Time
+	now      func() time.Time // Replaced in tests
+}
+
+type memoryWindow struct {
+	hits   []time.Time
+	window time.Duration
+}
+
+type memoryCounter struct {
+	count     int64
+	expiresAt time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		windows:  map[string]*memoryWindow{},
+		failures: map[string]memoryCounter{},
+		locks:    map[string]time.Time{},
+		now:      time.Now,
+	}
+}
+
+func (s *MemoryStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	w, ok := s.windows[key]
+	if !ok {
+		w = &memoryWindow{}
+		s.windows[key] = w
+	}
+	w.window = window
+
+	start := 0
+	for start < len(w.hits) && !w.hits[start].After(now.Add(-window)) {
+		start++
+	}
+	w.hits = w.hits[start:]
+
+	if len(w.hits) >= limit {
+		return false, w.hits[0].Add(window).Sub(now), nil
+	}
+	w.hits = append(w.hits, now)
+	return true, 0, nil
+}
+
+func (s *MemoryStore) RecordFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	counter := s.failures[key]
+	if now.After(counter.expiresAt) {
+		counter.count = 0
+	}
+	counter.count++
+	counter.expiresAt = now.Add(window)
+	s.failures[key] = counter
+	return counter.count, nil
+}
+
+func (s *MemoryStore) Lock(ctx context.Context, key string, duration time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.locks[key] = s.now().Add(duration)
+	return nil
+}
+
+func (s *MemoryStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	remaining := s.locks[key].Sub(s.now())
+	if remaining <= 0 {
+		delete(s.locks, key)
+		return 0, nil
+	}
+	return remaining, nil
+}
+
+func (s *MemoryStore) Reset(ctx context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		delete(s.failures, key)
+		delete(s.locks, key)
+	}
+	return nil
+}
+
+// sweep drops idle keys now and then so the maps don't grow without bound
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < time.Minute {
+		return
+	}
+	s.sweptAt = now
+
+	for key, w := range s.windows {
+		if len(w.hits) == 0 || now.Sub(w.hits[len(w.hits)-1]) > w.window {
+			delete(s.windows, key)
+		}
+	}
+	for key, counter := range s.failures {
+		if now.After(counter.expiresAt) {
+			delete(s.failures, key)
+		}
+	}
+	for key, until := range s.locks {
+		if now.After(until) {
+			delete(s.locks, key)
+		}
+	}
+}
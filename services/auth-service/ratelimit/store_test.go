@@ -0,0 +1,109 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeClock is a settable clock for the MemoryStore
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestMemoryStore() (*MemoryStore, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	store.now = clock.Now
+	return store, clock
+}
+
+func TestMemoryStoreSlidingWindow(t *testing.T) {
+	ctx := context.Background()
+	store, clock := newTestMemoryStore()
+	const limit, window = 3, time.Minute
+
+	// steps run in order against the same key
+	steps := []struct {
+		name     string
+		advance  time.Duration
+		allowed  bool
+		wantWait time.Duration
+	}{
+		{"first hit", 0, true, 0},
+		{"second hit", 10 * time.Second, true, 0},
+		{"third hit", 10 * time.Second, true, 0},
+		{"over the limit", 10 * time.Second, false, 30 * time.Second},
+		{"still full just before the first hit expires", 29 * time.Second, false, time.Second},
+		{"first hit expired", time.Second, true, 0},
+		{"full again", 0, false, 10 * time.Second},
+		{"whole window passed", window, true, 0},
+	}
+	for _, step := range steps {
+		clock.Advance(step.advance)
+		allowed, wait, err := store.Allow(ctx, "key", limit, window)
+		if err != nil {
+			t.Fatalf("%s: Allow: %v", step.name, err)
+		}
+		if allowed != step.allowed || wait != step.wantWait {
+			t.Errorf("%s: Allow() = (%v, %v), want (%v, %v)", step.name, allowed, wait, step.allowed, step.wantWait)
+		}
+	}
+}
+
+func TestMemoryStoreKeysAreIndependent(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestMemoryStore()
+
+	if allowed, _, _ := store.Allow(ctx, "a", 1, time.Minute); !allowed {
+		t.Fatal("first hit on a refused")
+	}
+	if allowed, _, _ := store.Allow(ctx, "a", 1, time.Minute); allowed {
+		t.Fatal("second hit on a allowed")
+	}
+	if allowed, _, _ := store.Allow(ctx, "b", 1, time.Minute); !allowed {
+		t.Fatal("hit on b refused because of a")
+	}
+}
+
+func TestMemoryStoreFailures(t *testing.T) {
+	ctx := context.Background()
+	store, clock := newTestMemoryStore()
+
+	for want := int64(1); want <= 3; want++ {
+		clock.Advance(30 * time.Minute)
+		if got, _ := store.RecordFailure(ctx, "key", time.Hour); got != want {
+			t.Fatalf("RecordFailure() = %d, want %d", got, want)
+		}
+	}
+
+	// Forgotten a window after the last failure
+	clock.Advance(time.Hour + time.Second)
+	if got, _ := store.RecordFailure(ctx, "key", time.Hour); got != 1 {
+		t.Errorf("RecordFailure() after the window = %d, want 1", got)
+	}
+
+	store.Reset(ctx, "key")
+	if got, _ := store.RecordFailure(ctx, "key", time.Hour); got != 1 {
+		t.Errorf("RecordFailure() after Reset = %d, want 1", got)
+	}
+}
+
+func TestMemoryStoreLock(t *testing.T) {
+	ctx := context.Background()
+	store, clock := newTestMemoryStore()
+
+	if remaining, _ := store.LockedFor(ctx, "key"); remaining != 0 {
+		t.Fatalf("LockedFor() before Lock = %v, want 0", remaining)
+	}
+	store.Lock(ctx, "key", time.Minute)
+	clock.Advance(20 * time.Second)
+	if remaining, _ := store.LockedFor(ctx, "key"); remaining != 40*time.Second {
+		t.Errorf("LockedFor() = %v, want 40s", remaining)
+	}
+	clock.Advance(40 * time.Second)
+	if remaining, _ := store.LockedFor(ctx, "key"); remaining != 0 {
+		t.Errorf("LockedFor() after expiry = %v, want 0", remaining)
+	}
+}
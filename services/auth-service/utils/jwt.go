@@ -1,55 +1,75 @@
 package utils
 
 import (
-	"errors"
+	"crypto"
+	"os"
+	"strconv"
 	"time"
 
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var jwtKey = []byte("your_secret_key") // Replace with a strong secret key
+// Claims are shared with every service through shared-libs/authz
+type Claims = authz.Claims
 
-type Claims struct {
-	Email string `json:"email"`
-	Role  string `json:"role"`
-	jwt.RegisteredClaims
-}
-
-// ✅ Generate JWT token
-func GenerateToken(email, role string) (string, error) {
-	expirationTime := time.Now().Add(24 * time.Hour) // Token expires in 24 hours
+// ✅ Generate short-lived JWT access token (subject = user ID, jti = session ID)
+func GenerateToken(userID, email, role, sessionID string) (string, error) {
+	expirationTime := time.Now().Add(AccessTokenTTL())
 	claims := &Claims{
 		Email: email,
 		Role:  role,
 		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			ID:        sessionID,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtKey)
+	key, err := currentSigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Private)
 }
 
-// ✅ Verify JWT token
+// ✅ Verify JWT token against the key named by its `kid` header
 func VerifyToken(tokenString string) (*Claims, error) {
-	claims := &Claims{}
-
-	// Parse and validate token
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
+	return authz.ParseToken(tokenString, func(kid string) (string, crypto.PublicKey, bool) {
+		key, ok := lookupKey(kid)
+		if !ok {
+			return "", nil, false
 		}
-		return jwtKey, nil
+		return key.Method.Alg(), key.Public, true
 	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ✅ Access token lifetime (ACCESS_TOKEN_TTL, default 15m)
+func AccessTokenTTL() time.Duration {
+	return DurationFromEnv("ACCESS_TOKEN_TTL", 15*time.Minute)
+}
 
-	// Check token validity
-	if !token.Valid {
-		return nil, errors.New("invalid token")
+// ✅ Refresh token lifetime (REFRESH_TOKEN_TTL, default 30 days)
+func RefreshTokenTTL() time.Duration {
+	return DurationFromEnv("REFRESH_TOKEN_TTL", 30*24*time.Hour)
+}
+
+// ✅ Read a duration such as "15m" from the environment
+func DurationFromEnv(key string, fallback time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil && d > 0 {
+		return d
 	}
+	return fallback
+}
 
-	return claims, nil
+// ✅ Read a non-negative integer from the environment, e.g. LOGIN_LOCKOUT_THRESHOLD=5
+func IntFromEnv(key string, fallback int) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil && n >= 0 {
+		return n
+	}
+	return fallback
 }
@@ -0,0 +1,198 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTKey is one key in the signing key set, identified by its `kid`.
+// Private is nil for verification-only keys kept around during rotation.
+type JWTKey struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+	Public  crypto.PublicKey
+}
+
+var (
+	keysMu     sync.RWMutex
+	signingKey *JWTKey
+	verifyKeys = map[string]*JWTKey{}
+)
+
+// ✅ Load signing & verification keys from JWT_KEYS_DIR
+//
+// Every *.pem file in the directory becomes a key whose `kid` is the file name
+// without extension. Private keys (PKCS#8 or PKCS#1 RSA, Ed25519) can sign;
+// public keys (PKIX) only verify, which lets a retired key keep validating
+// tokens until they expire. JWT_SIGNING_KEY_ID selects the active signing key,
+// otherwise the last private key in lexical order (e.g. "2025-03-01.pem") is used.
+func LoadSigningKeys() error {
+	dir := os.Getenv("JWT_KEYS_DIR")
+	if dir == "" {
+		fmt.Println("⚠️ JWT_KEYS_DIR not set, generating an ephemeral Ed25519 signing key (development only)")
+		key, err := generateEphemeralKey()
+		if err != nil {
+			return err
+		}
+		setKeys(key, []*JWTKey{key})
+		return nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+
+	var keys []*JWTKey
+	var signer *JWTKey
+	wantID := os.Getenv("JWT_SIGNING_KEY_ID")
+	for _, path := range paths {
+		key, err := loadKeyFile(path)
+		if err != nil {
+			return fmt.Errorf("❌ failed to load JWT key %s: %w", path, err)
+		}
+		keys = append(keys, key)
+		if key.Private != nil && (wantID == "" || key.ID == wantID) {
+			signer = key
+		}
+	}
+
+	if signer == nil {
+		return fmt.Errorf("❌ no private signing key found in %s", dir)
+	}
+	setKeys(signer, keys)
+	fmt.Printf("✅ Loaded %d JWT key(s), signing with kid=%s\n", len(keys), signer.ID)
+	return nil
+}
+
+// ✅ Current signing key
+func currentSigningKey() (*JWTKey, error) {
+	keysMu.RLock()
+	defer keysMu.RUnlock()
+	if signingKey == nil {
+		return nil, errors.New("signing keys not loaded")
+	}
+	return signingKey, nil
+}
+
+// ✅ Find verification key by `kid`
+func lookupKey(kid string) (*JWTKey, bool) {
+	keysMu.RLock()
+	defer keysMu.RUnlock()
+	key, ok := verifyKeys[kid]
+	return key, ok
+}
+
+// ✅ Public keys as a JWKS document
+func PublicJWKS() []authz.JSONWebKey {
+	keysMu.RLock()
+	defer keysMu.RUnlock()
+
+	ids := make([]string, 0, len(verifyKeys))
+	for id := range verifyKeys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	jwks := make([]authz.JSONWebKey, 0, len(ids))
+	for _, id := range ids {
+		key := verifyKeys[id]
+		jwk, err := authz.EncodePublicKey(key.ID, key.Method.Alg(), key.Public)
+		if err != nil {
+			continue
+		}
+		jwks = append(jwks, jwk)
+	}
+	return jwks
+}
+
+func setKeys(signer *JWTKey, keys []*JWTKey) {
+	byID := make(map[string]*JWTKey, len(keys))
+	for _, key := range keys {
+		byID[key.ID] = key
+	}
+
+	keysMu.Lock()
+	defer keysMu.Unlock()
+	signingKey = signer
+	verifyKeys = byID
+}
+
+func loadKeyFile(path string) (*JWTKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	id := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := parsed.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("unsupported private key type")
+		}
+		return newKey(id, signer, signer.Public())
+	case "RSA PRIVATE KEY":
+		parsed, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newKey(id, parsed, parsed.Public())
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newKey(id, nil, parsed)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+func newKey(id string, private crypto.Signer, public crypto.PublicKey) (*JWTKey, error) {
+	key := &JWTKey{ID: id, Private: private, Public: public}
+	switch public.(type) {
+	case *rsa.PublicKey:
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errors.New("only RSA and Ed25519 keys are supported")
+	}
+	return key, nil
+}
+
+func generateEphemeralKey() (*JWTKey, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(public)
+	return newKey("ephemeral-"+hex.EncodeToString(sum[:4]), private, public)
+}
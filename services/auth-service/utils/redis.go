@@ -46,53 +46,29 @@ func ConnectRedis() {
 	fmt.Println("✅ Connected to Redis at", redisAddr)
 }
 
-// ✅ Store Token in Redis (For Logout)
-func SaveTokenToBlacklist(token string, expiration int) error {
-	if RedisClient == nil {
-		return fmt.Errorf("redis is not connected")
-	}
-	ctx := context.Background()
-	err := RedisClient.Set(ctx, token, "blacklisted", time.Duration(expiration)*time.Second).Err()
-	return err
-}
-
-// ✅ Check if Token is Blacklisted
-func IsTokenBlacklisted(token string) bool {
-	if RedisClient == nil {
-		fmt.Println("❌ Redis is not connected")
-		return false
-	}
-
-	ctx := context.Background()
-	result, err := RedisClient.Get(ctx, token).Result()
-	if err == redis.Nil {
-		return false
-	}
-	return result == "blacklisted"
+// CachedUser is the login data cached per email
+type CachedUser struct {
+	ID            uint   `json:"id"`
+	Password      string `json:"password"`
+	Role          string `json:"role"`
+	EmailVerified bool   `json:"email_verified"`
 }
 
 // ✅ Cache User Details in Redis
-func CacheUser(email, password, role string) {
+func CacheUser(email string, user CachedUser) {
 	if RedisClient == nil {
 		fmt.Println("❌ Redis is not connected")
 		return
 	}
 
-	userData := map[string]string{
-		"password": password,
-		"role":     role,
-	}
-	jsonData, _ := json.Marshal(userData)
+	jsonData, _ := json.Marshal(user)
 
 	ctx := context.Background()
 	RedisClient.Set(ctx, email, jsonData, 5*time.Minute) // Cache for 5 mins
 }
 
 // ✅ Retrieve Cached User from Redis
-func GetCachedUser(email string) (*struct {
-	Password string `json:"password"`
-	Role     string `json:"role"`
-}, error) {
+func GetCachedUser(email string) (*CachedUser, error) {
 	if RedisClient == nil {
 		return nil, fmt.Errorf("❌ Redis is not connected")
 	}
@@ -105,10 +81,15 @@ func GetCachedUser(email string) (*struct {
 		return nil, err
 	}
 
-	var cachedUser struct {
-		Password string `json:"password"`
-		Role     string `json:"role"`
-	}
+	var cachedUser CachedUser
 	json.Unmarshal([]byte(data), &cachedUser)
 	return &cachedUser, nil
 }
+
+// ✅ Drop cached user (password or verification status changed)
+func DeleteCachedUser(email string) {
+	if RedisClient == nil {
+		return
+	}
+	RedisClient.Del(context.Background(), email)
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// CachedRefreshToken is the Redis copy of a refresh token row, keyed by token hash
+type CachedRefreshToken struct {
+	UserID    uint      `json:"user_id"`
+	FamilyID  string    `json:"family_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ✅ Generate an opaque token (refresh, reset & verification tokens; returned to the client, never stored)
+func GenerateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// ✅ Generate an ID shared by every refresh token descended from one login
+func GenerateTokenFamilyID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ✅ Hash opaque token for storage & lookup
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ✅ Cache refresh token metadata in Redis until it expires
+func CacheRefreshToken(hash string, token CachedRefreshToken) {
+	if RedisClient == nil {
+		return
+	}
+
+	ttl := time.Until(token.ExpiresAt)
+	if ttl <= 0 {
+		return
+	}
+	jsonData, _ := json.Marshal(token)
+	RedisClient.Set(context.Background(), refreshTokenKey(hash), jsonData, ttl)
+}
+
+// ✅ Retrieve cached refresh token metadata from Redis
+func GetCachedRefreshToken(hash string) (*CachedRefreshToken, error) {
+	if RedisClient == nil {
+		return nil, fmt.Errorf("❌ Redis is not connected")
+	}
+
+	data, err := RedisClient.Get(context.Background(), refreshTokenKey(hash)).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("❌ refresh token not found in cache")
+	} else if err != nil {
+		return nil, err
+	}
+
+	var token CachedRefreshToken
+	if err := json.Unmarshal([]byte(data), &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// ✅ Drop a refresh token from the cache once it has been used or revoked
+func DeleteCachedRefreshToken(hash string) {
+	if RedisClient == nil {
+		return
+	}
+	RedisClient.Del(context.Background(), refreshTokenKey(hash))
+}
+
+func refreshTokenKey(hash string) string {
+	return "refresh_token:" + hash
+}
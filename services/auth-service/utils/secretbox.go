@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const encryptedPrefix = "v1:"
+
+// ✅ Encrypt a secret at rest (AES-256-GCM with SECRETS_ENCRYPTION_KEY, base64 32 bytes).
+// Without a key the value is stored as-is, which is only acceptable locally.
+func EncryptSecret(plaintext string) (string, error) {
+	gcm, err := secretsCipher()
+	if err != nil {
+		return "", err
+	}
+	if gcm == nil {
+		fmt.Println("⚠️ SECRETS_ENCRYPTION_KEY not set, storing secret unencrypted")
+		return plaintext, nil
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// ✅ Decrypt a value produced by EncryptSecret
+func DecryptSecret(stored string) (string, error) {
+	if !strings.HasPrefix(stored, encryptedPrefix) {
+		return stored, nil
+	}
+
+	gcm, err := secretsCipher()
+	if err != nil {
+		return "", err
+	}
+	if gcm == nil {
+		return "", errors.New("SECRETS_ENCRYPTION_KEY is required to decrypt stored secrets")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, encryptedPrefix))
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("encrypted secret is too short")
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func secretsCipher() (cipher.AEAD, error) {
+	encoded := os.Getenv("SECRETS_ENCRYPTION_KEY")
+	if encoded == "" {
+		return nil, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(key) != 32 {
+		return nil, errors.New("SECRETS_ENCRYPTION_KEY must be 32 bytes, base64 encoded")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ✅ Caller IP. `x-forwarded-for` is only believed when the TCP peer is a
+// proxy listed in TRUSTED_PROXY_CIDRS (e.g. the grpc-gateway pods); the hops
+// are then read right to left and the first one outside those ranges is the
+// client. Anyone else could put any address in the header.
+func ClientIP(ctx context.Context) string {
+	peerIP := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(peerIP); err == nil {
+			peerIP = host
+		}
+	}
+
+	if isTrustedProxy(peerIP) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			var hops []string
+			for _, value := range md.Get("x-forwarded-for") {
+				hops = append(hops, strings.Split(value, ",")...)
+			}
+			for i := len(hops) - 1; i >= 0; i-- {
+				hop := strings.TrimSpace(hops[i])
+				if hop == "" {
+					continue
+				}
+				if i == 0 || !isTrustedProxy(hop) {
+					return hop
+				}
+			}
+		}
+	}
+
+	if peerIP == "" {
+		return "unknown"
+	}
+	return peerIP
+}
+
+// Proxies whose `x-forwarded-for` is believed, see LoadTrustedProxies
+var trustedProxies []*net.IPNet
+
+// ✅ Load TRUSTED_PROXY_CIDRS: comma separated CIDRs such as "10.0.0.0/8", a
+// bare IP being a single address. Unset means no proxy is trusted.
+func LoadTrustedProxies() error {
+	var networks []*net.IPNet
+	for _, entry := range strings.Split(os.Getenv("TRUSTED_PROXY_CIDRS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			if ip := net.ParseIP(entry); ip != nil && ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return fmt.Errorf("invalid TRUSTED_PROXY_CIDRS entry %q: %w", entry, err)
+		}
+		networks = append(networks, network)
+	}
+	trustedProxies = networks
+	return nil
+}
+
+func isTrustedProxy(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ✅ Caller user agent (the browser's when called through the grpc-gateway)
+func ClientUserAgent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if values := md.Get(key); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIP(t *testing.T) {
+	t.Setenv("TRUSTED_PROXY_CIDRS", "10.0.0.0/8, 192.168.1.5")
+	if err := LoadTrustedProxies(); err != nil {
+		t.Fatalf("LoadTrustedProxies: %v", err)
+	}
+	t.Cleanup(func() { trustedProxies = nil })
+
+	tests := []struct {
+		name      string
+		peer      string
+		forwarded []string
+		want      string
+	}{
+		{"no header", "203.0.113.7:4000", nil, "203.0.113.7"},
+		{"untrusted peer can't spoof", "203.0.113.7:4000", []string{"198.51.100.1"}, "203.0.113.7"},
+		{"gateway", "10.1.2.3:4000", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"single trusted address", "192.168.1.5:4000", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"spoofed hop before the client", "10.1.2.3:4000", []string{"1.2.3.4, 198.51.100.1"}, "198.51.100.1"},
+		{"load balancer behind the gateway", "10.1.2.3:4000", []string{"198.51.100.1, 10.9.9.9"}, "198.51.100.1"},
+		{"header split over values", "10.1.2.3:4000", []string{"1.2.3.4", "198.51.100.1"}, "198.51.100.1"},
+		{"only proxies", "10.1.2.3:4000", []string{"10.9.9.9"}, "10.9.9.9"},
+		{"trusted peer without header", "10.1.2.3:4000", nil, "10.1.2.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := net.ResolveTCPAddr("tcp", tt.peer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+			if tt.forwarded != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-forwarded-for": tt.forwarded})
+			}
+			if got := ClientIP(ctx); got != tt.want {
+				t.Errorf("ClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadTrustedProxiesRejectsInvalidEntries(t *testing.T) {
+	t.Setenv("TRUSTED_PROXY_CIDRS", "10.0.0.0/8,not-a-cidr")
+	if err := LoadTrustedProxies(); err == nil {
+		t.Fatal("expected an error for an invalid entry")
+	}
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+// RFC 6238 Appendix B test key ("12345678901234567890") in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestValidateTOTPRFC6238Vectors(t *testing.T) {
+	// The SHA1 column of Appendix B; our codes are the last 6 of the 8 digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},          // 94287082
+		{1111111109, "081804"},  // 07081804
+		{1111111111, "050471"},  // 14050471
+		{1234567890, "005924"},  // 89005924
+		{2000000000, "279037"},  // 69279037
+		{20000000000, "353130"}, // 65353130
+	}
+	for _, tt := range tests {
+		now := time.Unix(tt.unix, 0)
+		step, ok := ValidateTOTP(rfcSecret, tt.code, 0, now)
+		if !ok {
+			t.Errorf("ValidateTOTP(%s) at %d rejected a valid code", tt.code, tt.unix)
+			continue
+		}
+		if want := tt.unix / totpPeriod; step != want {
+			t.Errorf("ValidateTOTP(%s) at %d matched step %d, want %d", tt.code, tt.unix, step, want)
+		}
+	}
+}
+
+func TestValidateTOTPSkew(t *testing.T) {
+	key, err := totpEncoding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1234567890, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name   string
+		offset int64
+		want   bool
+	}{
+		{"two steps behind", -2, false},
+		{"one step behind", -1, true},
+		{"current step", 0, true},
+		{"one step ahead", 1, true},
+		{"two steps ahead", 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := totpCode(key, uint64(current+tt.offset))
+			step, ok := ValidateTOTP(rfcSecret, code, 0, now)
+			if ok != tt.want {
+				t.Fatalf("ValidateTOTP() ok = %v, want %v", ok, tt.want)
+			}
+			if ok && step != current+tt.offset {
+				t.Errorf("ValidateTOTP() step = %d, want %d", step, current+tt.offset)
+			}
+		})
+	}
+}
+
+func TestValidateTOTPReplay(t *testing.T) {
+	key, err := totpEncoding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1234567890, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name         string
+		codeStep     int64
+		lastUsedStep int64
+		want         bool
+	}{
+		{"first use", current, 0, true},
+		{"newer than the last code", current, current - 1, true},
+		{"same code again", current, current, false},
+		{"older code after a newer one", current - 1, current, false},
+		{"code within skew but already used", current + 1, current + 1, false},
+		{"next code after a skewed one", current + 1, current, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := totpCode(key, uint64(tt.codeStep))
+			if _, ok := ValidateTOTP(rfcSecret, code, tt.lastUsedStep, now); ok != tt.want {
+				t.Errorf("ValidateTOTP() ok = %v, want %v", ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTOTPRejectsMalformedInput(t *testing.T) {
+	now := time.Unix(59, 0)
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+	}{
+		{"wrong code", rfcSecret, "123456"},
+		{"too short", rfcSecret, "28708"},
+		{"eight digits", rfcSecret, "94287082"},
+		{"empty", rfcSecret, ""},
+		{"invalid secret", "not base32!", "287082"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := ValidateTOTP(tt.secret, tt.code, 0, now); ok {
+				t.Error("ValidateTOTP() accepted an invalid code")
+			}
+		})
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	for _, code := range []string{"k3tq-9xam", "K3TQ-9XAM", " k3tq 9xam ", "k3tq9xam"} {
+		if got := NormalizeRecoveryCode(code); got != "k3tq9xam" {
+			t.Errorf("NormalizeRecoveryCode(%q) = %q, want %q", code, got, "k3tq9xam")
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/redis/go-redis/v9"
+)
+
+const usageKeyPrefix = "gateway:apikey:usage:"
+
+// APIKeyConfig is a partner (e.g. a coaching institute) calling the API with
+// an X-Api-Key header. Only the SHA-256 of the key is configured; generate
+// one with `grpc-gateway -new-api-key`.
+type APIKeyConfig struct {
+	ID         string `yaml:"id"` // Sent to the services as x-api-key-id metadata
+	Name       string `yaml:"name"`
+	Hash       string `yaml:"hash"` // Hex SHA-256 of the key
+	Rate       Bucket `yaml:"rate"`
+	DailyQuota int64  `yaml:"daily_quota"` // Requests per UTC day; 0 is unlimited
+	Disabled   bool   `yaml:"disabled"`
+}
+
+// UsageStore counts requests per API key and UTC day
+type UsageStore interface {
+	// Incr counts one request and returns the day's total
+	Incr(ctx context.Context, keyID, day string) (int64, error)
+	Get(ctx context.Context, keyID, day string) (int64, error)
+}
+
+type apiKeyRegistry struct {
+	byHash map[[sha256.Size]byte]APIKeyConfig
+	keys   []APIKeyConfig
+	usage  UsageStore
+}
+
+func newAPIKeyRegistry(keys []APIKeyConfig, usage UsageStore) (*apiKeyRegistry, error) {
+	registry := &apiKeyRegistry{byHash: map[[sha256.Size]byte]APIKeyConfig{}, usage: usage}
+	seen := map[string]bool{}
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, fmt.Errorf("API key %q has no id", key.Name)
+		}
+		if seen[key.ID] {
+			return nil, fmt.Errorf("API key %q is configured twice", key.ID)
+		}
+		seen[key.ID] = true
+		hash, err := hex.DecodeString(key.Hash)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("API key %q: hash must be a hex SHA-256", key.ID)
+		}
+		registry.byHash[[sha256.Size]byte(hash)] = key
+		registry.keys = append(registry.keys, key)
+	}
+	return registry, nil
+}
+
+// lookup finds an enabled key. The map lookup is on the hash of the key, so
+// it doesn't leak how much of a guessed key was right.
+func (r *apiKeyRegistry) lookup(raw string) (APIKeyConfig, bool) {
+	key, ok := r.byHash[sha256.Sum256([]byte(raw))]
+	if !ok || key.Disabled {
+		return APIKeyConfig{}, false
+	}
+	return key, true
+}
+
+// consume counts the request against the key's daily quota and reports it in
+// X-Quota-* headers, answering 429 once the quota is spent. A failing usage
+// store lets requests through rather than taking partners offline.
+func (r *apiKeyRegistry) consume(w http.ResponseWriter, req *http.Request, key APIKeyConfig) bool {
+	now := time.Now().UTC()
+	used, err := r.usage.Incr(req.Context(), key.ID, now.Format(time.DateOnly))
+	if err != nil {
+		log.Printf("⚠️ Failed to count usage of API key %s: %v", key.ID, err)
+		return true
+	}
+	if key.DailyQuota <= 0 {
+		return true
+	}
+
+	reset := now.Truncate(24 * time.Hour).Add(24 * time.Hour)
+	w.Header().Set("X-Quota-Limit", strconv.FormatInt(key.DailyQuota, 10))
+	w.Header().Set("X-Quota-Remaining", strconv.FormatInt(max(key.DailyQuota-used, 0), 10))
+	w.Header().Set("X-Quota-Reset", strconv.FormatInt(reset.Unix(), 10))
+	if used > key.DailyQuota {
+		writeProblem(w, req, apperr.ResourceExhausted(apperr.ReasonQuotaExceeded, "daily quota exceeded for this API key", reset.Sub(now)))
+		return false
+	}
+	return true
+}
+
+type keyUsage struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Disabled   bool   `json:"disabled"`
+	DailyQuota int64  `json:"daily_quota"`
+	Used       int64  `json:"used"`
+}
+
+// usageHandler reports each key's usage for ?day=YYYY-MM-DD (default today).
+// It's served on the admin listener only, never on the public one.
+func (r *apiKeyRegistry) usageHandler(adminToken string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if adminToken != "" && subtle.ConstantTimeCompare([]byte(req.Header.Get("Authorization")), []byte("Bearer "+adminToken)) != 1 {
+			writeProblem(w, req, apperr.Unauthenticated(apperr.ReasonAuthenticationRequired, "admin token required"))
+			return
+		}
+		day := req.URL.Query().Get("day")
+		if day == "" {
+			day = time.Now().UTC().Format(time.DateOnly)
+		} else if _, err := time.Parse(time.DateOnly, day); err != nil {
+			writeProblem(w, req, apperr.InvalidField("day", "day must be YYYY-MM-DD"))
+			return
+		}
+
+		usage := make([]keyUsage, 0, len(r.keys))
+		for _, key := range r.keys {
+			used, err := r.usage.Get(req.Context(), key.ID, day)
+			if err != nil {
+				writeProblem(w, req, apperr.Unavailable("usage store unavailable").Wrap(err))
+				return
+			}
+			usage = append(usage, keyUsage{ID: key.ID, Name: key.Name, Disabled: key.Disabled, DailyQuota: key.DailyQuota, Used: used})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"day": day, "keys": usage})
+	})
+}
+
+// memoryUsage counts usage per gateway instance, for a single instance or development
+type memoryUsage struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+func newMemoryUsage() *memoryUsage {
+	return &memoryUsage{counts: map[string]int64{}}
+}
+
+func (m *memoryUsage) Incr(_ context.Context, keyID, day string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// Only today's counters are kept
+	for k := range m.counts {
+		if k[:len(time.DateOnly)] != day {
+			delete(m.counts, k)
+		}
+	}
+	m.counts[day+":"+keyID]++
+	return m.counts[day+":"+keyID], nil
+}
+
+func (m *memoryUsage) Get(_ context.Context, keyID, day string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.counts[day+":"+keyID], nil
+}
+
+// redisUsage shares the counters between gateway instances
+type redisUsage struct {
+	client *redis.Client
+}
+
+func (s *redisUsage) Incr(ctx context.Context, keyID, day string) (int64, error) {
+	key := usageKeyPrefix + keyID + ":" + day
+	pipe := s.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	// Kept a few days so yesterday's usage can still be looked up
+	pipe.Expire(ctx, key, 72*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (s *redisUsage) Get(ctx context.Context, keyID, day string) (int64, error) {
+	used, err := s.client.Get(ctx, usageKeyPrefix+keyID+":"+day).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return used, err
+}
+
+// newUsageStore uses Redis when configured and reachable, like auth-service's
+// rate limiter, and memory otherwise
+func newUsageStore(ctx context.Context, addr string) UsageStore {
+	if addr == "" {
+		return newMemoryUsage()
+	}
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	pingCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		log.Printf("⚠️ Redis at %s unavailable (%v), counting API key usage in memory", addr, err)
+		client.Close()
+		return newMemoryUsage()
+	}
+	log.Printf("✅ Counting API key usage in Redis at %s", addr)
+	return &redisUsage{client: client}
+}
+
+// printNewAPIKey generates a key to hand to a partner and the hash to configure
+func printNewAPIKey() error {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	key := "nck_" + base64.RawURLEncoding.EncodeToString(b)
+	hash := sha256.Sum256([]byte(key))
+	fmt.Printf("key:  %s\nhash: %s\n", key, hex.EncodeToString(hash[:]))
+	return nil
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is read from GATEWAY_CONFIG (default gateway.yaml). ${VAR} and
+// ${VAR:-default} are expanded from the environment, so one file works across
+// deployments.
+type Config struct {
+	Listen          string          `yaml:"listen"`
+	ShutdownTimeout time.Duration   `yaml:"shutdown_timeout"`
+	Docs            bool            `yaml:"docs"` // Serve /openapi.json and Swagger UI at /docs
+	CORS            CORSConfig      `yaml:"cors"`
+	Limits          LimitsConfig    `yaml:"limits"`
+	RateLimit       RateLimitConfig `yaml:"rate_limit"`
+	APIKeys         []APIKeyConfig  `yaml:"api_keys"`
+	Admin           AdminConfig     `yaml:"admin"`
+	WebSocket       WebSocketConfig `yaml:"websocket"` // Streaming RPCs at /ws/{service}/{method}
+	Services        []ServiceConfig `yaml:"services"`
+}
+
+// AdminConfig is a separate listener for operators (API key usage); keep it
+// off the public network. Empty Listen disables it.
+type AdminConfig struct {
+	Listen string `yaml:"listen"`
+	Token  string `yaml:"token"` // Required as a bearer token when set
+}
+
+// ServiceConfig is one gRPC backend; Name picks its handlers from the registry in services.go
+type ServiceConfig struct {
+	Name     string    `yaml:"name"`
+	Endpoint string    `yaml:"endpoint"`
+	TLS      TLSConfig `yaml:"tls"`
+}
+
+// TLSConfig secures the connection to a backend. Without CAFile the system
+// roots are trusted; CertFile and KeyFile add a client certificate (mTLS).
+type TLSConfig struct {
+	Enabled            bool   `yaml:"enabled"`
+	CAFile             string `yaml:"ca_file"`
+	CertFile           string `yaml:"cert_file"`
+	KeyFile            string `yaml:"key_file"`
+	ServerName         string `yaml:"server_name"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"` // Development only
+}
+
+type CORSConfig struct {
+	AllowedOrigins   []string      `yaml:"allowed_origins"` // Exact origins, "*", or wildcards like "https://*.example.com"
+	AllowedMethods   []string      `yaml:"allowed_methods"`
+	AllowedHeaders   []string      `yaml:"allowed_headers"`
+	ExposedHeaders   []string      `yaml:"exposed_headers"`
+	AllowCredentials bool          `yaml:"allow_credentials"`
+	MaxAge           time.Duration `yaml:"max_age"`
+}
+
+// defaultConfig routes to the local auth and user services, as before the
+// gateway was configurable
+func defaultConfig() Config {
+	return Config{
+		Listen:          ":8080",
+		ShutdownTimeout: 15 * time.Second,
+		Docs:            true,
+		CORS: CORSConfig{
+			AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+			AllowedHeaders: []string{"Authorization", "Content-Type", "X-Request-Id", "X-Api-Key"},
+			ExposedHeaders: []string{"X-Request-Id", "Retry-After", "X-Quota-Limit", "X-Quota-Remaining", "X-Quota-Reset"},
+			MaxAge:         10 * time.Minute,
+		},
+		Limits: LimitsConfig{
+			MaxBodyBytes: 1 << 20,
+			// Avatar uploads carry the image in the body
+			BodyLimits:     []BodyLimit{{Path: "/api/v1/users/*/avatar", MaxBytes: 8 << 20}},
+			RequestTimeout: 30 * time.Second,
+		},
+		RateLimit: RateLimitConfig{
+			Anonymous:     Bucket{Rate: 5, Burst: 20},
+			Authenticated: Bucket{Rate: 20, Burst: 60},
+			RedisAddr:     os.Getenv("REDIS_ADDR"),
+		},
+		WebSocket: WebSocketConfig{
+			Enabled:         true,
+			PingInterval:    30 * time.Second,
+			WriteTimeout:    10 * time.Second,
+			MaxMessageBytes: 64 << 10,
+		},
+		Services: []ServiceConfig{
+			{Name: "auth", Endpoint: envOr("AUTH_SERVICE_ADDR", "localhost:50051")},
+			{Name: "user", Endpoint: envOr("USER_SERVICE_ADDR", "localhost:50052")},
+		},
+	}
+}
+
+// loadConfig reads the config file over the defaults. A missing default file
+// is fine; a missing file named in GATEWAY_CONFIG is not.
+func loadConfig() (Config, error) {
+	config := defaultConfig()
+	file, explicit := os.LookupEnv("GATEWAY_CONFIG")
+	if !explicit {
+		file = "gateway.yaml"
+	}
+
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return config, config.validate()
+	}
+	if err != nil {
+		return config, err
+	}
+	if err := yaml.Unmarshal([]byte(os.Expand(string(data), expandVar)), &config); err != nil {
+		return config, fmt.Errorf("%s: %w", file, err)
+	}
+	return config, config.validate()
+}
+
+func (c Config) validate() error {
+	if len(c.Services) == 0 {
+		return errors.New("no services configured")
+	}
+	seen := map[string]bool{}
+	for _, service := range c.Services {
+		if _, ok := registry[service.Name]; !ok {
+			return fmt.Errorf("unknown service %q (known: %s)", service.Name, knownServices())
+		}
+		if seen[service.Name] {
+			return fmt.Errorf("service %q is configured twice", service.Name)
+		}
+		seen[service.Name] = true
+		if service.Endpoint == "" {
+			return fmt.Errorf("service %q has no endpoint", service.Name)
+		}
+	}
+	if c.WebSocket.Enabled && (c.WebSocket.PingInterval <= 0 || c.WebSocket.WriteTimeout <= 0) {
+		return errors.New("websocket ping_interval and write_timeout must be positive")
+	}
+	if c.CORS.AllowCredentials && slices.Contains(c.CORS.AllowedOrigins, "*") {
+		return errors.New(`cors allow_credentials can't be combined with allowed_origins "*": it would let any site make credentialed requests`)
+	}
+	for _, limit := range c.Limits.BodyLimits {
+		if _, err := path.Match(limit.Path, ""); err != nil {
+			return fmt.Errorf("body limit path %q: %w", limit.Path, err)
+		}
+	}
+	return nil
+}
+
+// credentials builds the transport credentials for a backend
+func (t TLSConfig) credentials() (credentials.TransportCredentials, error) {
+	if !t.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+	if t.CAFile != "" {
+		pem, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in %s", t.CAFile)
+		}
+	}
+	if t.CertFile != "" || t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// expandVar resolves VAR or VAR:-default
+func expandVar(name string) string {
+	name, fallback, _ := strings.Cut(name, ":-")
+	return envOr(name, fallback)
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
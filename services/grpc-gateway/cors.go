@@ -0,0 +1,73 @@
+package main
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// withCORS lets the configured origins (e.g. the Flutter web build) call the
+// API from the browser and answers preflight requests
+func withCORS(config CORSConfig, next http.Handler) http.Handler {
+	if len(config.AllowedOrigins) == 0 {
+		return next
+	}
+	methods := strings.Join(config.AllowedMethods, ", ")
+	headers := strings.Join(config.AllowedHeaders, ", ")
+	exposed := strings.Join(config.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(config.MaxAge.Seconds()))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		w.Header().Add("Vary", "Origin")
+		if origin == "" || !originAllowed(config.AllowedOrigins, origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Credentials can't be combined with a literal "*"
+		if config.AllowCredentials {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		} else if slices.Contains(config.AllowedOrigins, "*") {
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			w.Header().Set("Access-Control-Allow-Methods", methods)
+			w.Header().Set("Access-Control-Allow-Headers", headers)
+			if config.MaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", maxAge)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if exposed != "" {
+			w.Header().Set("Access-Control-Expose-Headers", exposed)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// originAllowed matches exact origins, "*", and one leading wildcard label
+// such as "https://*.neetchamp.app"
+func originAllowed(allowed []string, origin string) bool {
+	for _, pattern := range allowed {
+		if pattern == "*" || strings.EqualFold(pattern, origin) {
+			return true
+		}
+		if prefix, suffix, ok := strings.Cut(pattern, "*"); ok {
+			if len(origin) > len(prefix)+len(suffix) &&
+				strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) &&
+				!strings.Contains(origin[len(prefix):len(origin)-len(suffix)], "/") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/openapi"
+	"github.com/swaggest/swgui/v5emb"
+)
+
+// withDocs serves the merged OpenAPI documents of all services and a Swagger
+// UI for them; everything else goes to the API
+func withDocs(next http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /openapi.json", serveSpec(openapi.V3))
+	mux.HandleFunc("GET /openapi.v2.json", serveSpec(openapi.V2))
+	mux.Handle("GET /docs/", v5emb.New("NeetChamp API", "/openapi.json", "/docs/"))
+	mux.Handle("GET /docs", http.RedirectHandler("/docs/", http.StatusMovedPermanently))
+	mux.Handle("/", next)
+	return mux
+}
+
+func serveSpec(spec []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(spec)
+	}
+}
@@ -8,15 +8,24 @@ replace github.com/Aditya-PS-05/NeetChamp/shared-libs => ../../shared-libs
 
 require (
 	github.com/Aditya-PS-05/NeetChamp/shared-libs v0.0.0-00010101000000-000000000000
+	github.com/coder/websocket v1.8.12
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.26.3
+	github.com/redis/go-redis/v9 v9.7.1
+	github.com/swaggest/swgui v1.8.5
+	golang.org/x/time v0.10.0
 	google.golang.org/grpc v1.71.0
+	google.golang.org/protobuf v1.36.5
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/golang-jwt/jwt/v5 v5.2.1 // indirect
+	github.com/vearutop/statigz v1.4.0 // indirect
 	golang.org/x/net v0.35.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
 	golang.org/x/text v0.22.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20250303144028-a0af3efb3deb // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250303144028-a0af3efb3deb // indirect
-	google.golang.org/protobuf v1.36.5 // indirect
 )
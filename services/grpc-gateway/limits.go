@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"google.golang.org/grpc/codes"
+)
+
+// errRequestTooLarge is answered with 413 (see problemStatus)
+var errRequestTooLarge = apperr.New(codes.ResourceExhausted, apperr.ReasonRequestTooLarge, "request body is too large")
+
+type LimitsConfig struct {
+	MaxBodyBytes   int64         `yaml:"max_body_bytes"`  // Default for every route; 0 disables the limit
+	BodyLimits     []BodyLimit   `yaml:"body_limits"`     // Routes allowed bigger (or smaller) bodies
+	RequestTimeout time.Duration `yaml:"request_timeout"` // Becomes the deadline of the gRPC call
+}
+
+// BodyLimit overrides MaxBodyBytes for paths matching Path, a path.Match
+// pattern such as "/api/v1/users/*/avatar"
+type BodyLimit struct {
+	Path     string `yaml:"path"`
+	MaxBytes int64  `yaml:"max_bytes"`
+}
+
+func (c LimitsConfig) bodyLimit(urlPath string) int64 {
+	for _, limit := range c.BodyLimits {
+		if ok, _ := path.Match(limit.Path, urlPath); ok {
+			return limit.MaxBytes
+		}
+	}
+	return c.MaxBodyBytes
+}
+
+// withLimits caps request bodies and gives every request a deadline, which
+// grpc-gateway passes on to the backend as the gRPC deadline
+func withLimits(config LimitsConfig, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limit := config.bodyLimit(r.URL.Path); limit > 0 {
+			if r.ContentLength > limit {
+				writeProblem(w, r, errRequestTooLarge.WithMessage("request body is too large (max %d bytes)", limit))
+				return
+			}
+			// Catches chunked bodies that don't declare a length
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+		}
+		if config.RequestTimeout > 0 {
+			ctx, cancel := context.WithTimeout(r.Context(), config.RequestTimeout)
+			defer cancel()
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
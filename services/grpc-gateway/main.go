@@ -2,39 +2,185 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
 	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 )
 
-const (
-	grpcAuthServiceEndpoint = "localhost:50051" // Ensure auth-service is running
-)
-
 func run() error {
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	// ✅ Stop on SIGINT/SIGTERM, letting in-flight requests finish
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithErrorHandler(problemHandler),
+	)
+
+	// Register every configured backend to handle REST API requests
+	conns := map[string]*grpc.ClientConn{}
+	for _, service := range config.Services {
+		creds, err := service.TLS.credentials()
+		if err != nil {
+			return fmt.Errorf("❌ Invalid TLS config for %s service: %w", service.Name, err)
+		}
+		conn, err := grpc.NewClient(service.Endpoint, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return fmt.Errorf("❌ Failed to connect to %s service: %w", service.Name, err)
+		}
+		defer conn.Close()
+		conns[service.Name] = conn
+		if err := registry[service.Name].register(ctx, mux, conn); err != nil {
+			return fmt.Errorf("❌ Failed to register %s service: %w", service.Name, err)
+		}
+		log.Printf("✅ Routing %s service to %s", service.Name, service.Endpoint)
+	}
+
+	// ✅ Rate limit per API key, user or IP; users are only told apart when
+	// their token can be verified against auth-service's keys
+	keys, err := newAPIKeyRegistry(config.APIKeys, newUsageStore(ctx, config.RateLimit.RedisAddr))
+	if err != nil {
+		return fmt.Errorf("❌ Invalid API key config: %w", err)
+	}
+	limits := &edge{config: config.RateLimit, limiter: newRateLimiter(), keys: keys}
+	if conn, ok := conns["auth"]; ok {
+		limits.verifier = authz.NewRemoteKeySet(authServiceKeys(auth.NewAuthServiceClient(conn)))
+	}
+	go limits.limiter.forgetIdle(ctx)
+
+	var handler http.Handler = withLimits(config.Limits, limits.handler(mux))
+	if config.WebSocket.Enabled {
+		// Sockets outlive the request timeout, so they skip withLimits
+		bridge := newWSBridge(ctx, config.WebSocket, config.CORS, conns, limits.verifier)
+		root := http.NewServeMux()
+		root.Handle("/", handler)
+		root.Handle("GET /ws/{service}/{method}", withQueryToken(limits.handler(bridge)))
+		handler = root
+	}
+	if config.Docs {
+		handler = withDocs(handler)
+	}
+	server := &http.Server{
+		Addr:              config.Listen,
+		Handler:           withRequestID(withCORS(config.CORS, handler)),
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+	serveErr := make(chan error, 2)
+	go func() {
+		log.Printf("🚀 gRPC-Gateway running on %s...", config.Listen)
+		serveErr <- server.ListenAndServe()
+	}()
+
+	var admin *http.Server
+	if config.Admin.Listen != "" {
+		adminMux := http.NewServeMux()
+		adminMux.Handle("GET /admin/api-keys/usage", keys.usageHandler(config.Admin.Token))
+		admin = &http.Server{Addr: config.Admin.Listen, Handler: adminMux, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			log.Printf("🔧 Admin endpoints on %s", config.Admin.Listen)
+			serveErr <- admin.ListenAndServe()
+		}()
+	}
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+	log.Println("🛑 Shutting down gRPC-Gateway...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
 	defer cancel()
+	if admin != nil {
+		admin.Shutdown(shutdownCtx)
+	}
+	return server.Shutdown(shutdownCtx)
+}
+
+// ✅ Forward the caller's credentials and X-Request-Id to the services as
+// `authorization` and `x-request-id` metadata (used for authz and audit logs)
+func headerMatcher(key string) (string, bool) {
+	switch {
+	case strings.EqualFold(key, "Authorization"):
+		return "authorization", true
+	case strings.EqualFold(key, "X-Request-Id"):
+		return "x-request-id", true
+	case strings.EqualFold(key, "X-Api-Key-Id"):
+		// Set by the rate limiter once the key is verified
+		return "x-api-key-id", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
 
-	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithInsecure()}
+// authServiceKeys fetches the JWKS from auth-service over gRPC
+func authServiceKeys(client auth.AuthServiceClient) authz.KeySource {
+	return func(ctx context.Context) ([]authz.JSONWebKey, error) {
+		resp, err := client.GetJWKS(ctx, &auth.GetJWKSRequest{})
+		if err != nil {
+			return nil, err
+		}
 
-	// Register AuthService to handle REST API requests
-	if err := auth.RegisterAuthServiceHandlerFromEndpoint(ctx, mux, grpcAuthServiceEndpoint, opts); err != nil {
-		log.Fatalf("❌ Failed to register auth service: %v", err)
+		keys := make([]authz.JSONWebKey, 0, len(resp.Keys))
+		for _, key := range resp.Keys {
+			keys = append(keys, authz.JSONWebKey{
+				Kty: key.Kty,
+				Kid: key.Kid,
+				Use: key.Use,
+				Alg: key.Alg,
+				N:   key.N,
+				E:   key.E,
+				Crv: key.Crv,
+				X:   key.X,
+			})
+		}
+		return keys, nil
 	}
+}
 
-	log.Println("🚀 gRPC-Gateway running on port 8080...")
-	return http.ListenAndServe(":8080", mux)
+// ✅ Give every request an X-Request-Id (kept if the client sent one) and echo it back
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-Id")
+		if id == "" || len(id) > 128 {
+			b := make([]byte, 16)
+			rand.Read(b)
+			id = hex.EncodeToString(b)
+			r.Header.Set("X-Request-Id", id)
+		}
+		w.Header().Set("X-Request-Id", id)
+		next.ServeHTTP(w, r)
+	})
 }
 
 func main() {
+	newAPIKey := flag.Bool("new-api-key", false, "print a new partner API key and its hash, then exit")
 	flag.Parse()
-	if err := run(); err != nil {
+	if *newAPIKey {
+		if err := printNewAPIKey(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if err := run(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatal(err)
 	}
 }
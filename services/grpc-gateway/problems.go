@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// problemTypeBase + the reason's slug is the "type" of every problem
+const problemTypeBase = "https://" + apperr.Domain + "/problems/"
+
+// problem is an RFC 7807 problem details document, extended with the
+// machine-readable reason (code), the request ID and the invalid fields
+type problem struct {
+	Type       string                  `json:"type"`
+	Title      string                  `json:"title"` // In the caller's language; safe to show to users
+	Status     int                     `json:"status"`
+	Detail     string                  `json:"detail,omitempty"` // English, for developers
+	Instance   string                  `json:"instance,omitempty"`
+	Code       apperr.Reason           `json:"code"`
+	RequestID  string                  `json:"request_id,omitempty"`
+	Errors     []apperr.FieldViolation `json:"errors,omitempty"`
+	RetryAfter int64                   `json:"retry_after,omitempty"` // Seconds
+	Metadata   map[string]string       `json:"metadata,omitempty"`
+}
+
+// problemStatus overrides the HTTP status of reasons whose gRPC code maps to
+// a less precise one
+var problemStatus = map[apperr.Reason]int{
+	apperr.ReasonRequestTooLarge: http.StatusRequestEntityTooLarge,
+}
+
+// problemHandler renders every error grpc-gateway sees (failed calls, unknown
+// routes, undecodable bodies) as application/problem+json
+func problemHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	// Routing errors such as 405 Method Not Allowed come with their own status
+	var statusErr *runtime.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		respondProblem(w, r, apperr.FromError(statusErr.Err), statusErr.HTTPStatus)
+		return
+	}
+	writeProblem(w, r, apperr.FromError(err))
+}
+
+// writeProblem answers r with e, translated to the best language of its
+// Accept-Language header
+func writeProblem(w http.ResponseWriter, r *http.Request, e *apperr.Error) {
+	httpStatus, ok := problemStatus[e.Reason]
+	if !ok {
+		httpStatus = runtime.HTTPStatusFromCode(e.Code)
+	}
+	respondProblem(w, r, e, httpStatus)
+}
+
+func respondProblem(w http.ResponseWriter, r *http.Request, e *apperr.Error, httpStatus int) {
+	lang := apperr.NegotiateLanguage(r.Header.Get("Accept-Language"))
+	p := problem{
+		Type:      problemTypeBase + e.Reason.Slug(),
+		Title:     e.Localized(lang),
+		Status:    httpStatus,
+		Instance:  r.URL.Path,
+		Code:      e.Reason,
+		RequestID: r.Header.Get("X-Request-Id"),
+		Errors:    e.Violations,
+		Metadata:  e.Metadata,
+	}
+	// Server errors may describe internals; their title says enough
+	if httpStatus < http.StatusInternalServerError || e.Reason != apperr.ReasonForCode(e.Code) {
+		p.Detail = e.Message
+	}
+
+	header := w.Header()
+	header.Del("Trailer")
+	header.Del("Transfer-Encoding")
+	header.Set("Content-Type", "application/problem+json")
+	header.Set("Content-Language", lang)
+	header.Add("Vary", "Accept-Language")
+	if e.RetryAfter > 0 {
+		p.RetryAfter = max(int64(math.Ceil(e.RetryAfter.Seconds())), 1)
+		header.Set("Retry-After", strconv.FormatInt(p.RetryAfter, 10))
+	}
+	if httpStatus == http.StatusUnauthorized {
+		header.Set("WWW-Authenticate", "Bearer")
+	}
+	w.WriteHeader(httpStatus)
+	json.NewEncoder(w).Encode(p)
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"golang.org/x/time/rate"
+)
+
+// Buckets unused for this long are forgotten
+const bucketIdleTimeout = 10 * time.Minute
+
+type RateLimitConfig struct {
+	Anonymous         Bucket `yaml:"anonymous"`           // Per client IP
+	Authenticated     Bucket `yaml:"authenticated"`       // Per user, from a verified access token
+	TrustForwardedFor bool   `yaml:"trust_forwarded_for"` // Behind a load balancer: the client IP is the last X-Forwarded-For entry
+	RedisAddr         string `yaml:"redis_addr"`          // Shares API key quotas and usage between gateway instances
+}
+
+// Bucket is a token bucket: Rate requests per second on average, with bursts
+// of up to Burst. A zero Rate is unlimited.
+type Bucket struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+// rateLimiter keeps a token bucket per client in memory, so limits apply per
+// gateway instance
+type rateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*clientBucket
+}
+
+type clientBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{buckets: map[string]*clientBucket{}}
+}
+
+// allow takes a token from the client's bucket, or says how long to wait for one
+func (l *rateLimiter) allow(client string, bucket Bucket) (bool, time.Duration) {
+	if bucket.Rate <= 0 {
+		return true, 0
+	}
+	now := time.Now()
+	l.mu.Lock()
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &clientBucket{limiter: rate.NewLimiter(rate.Limit(bucket.Rate), max(bucket.Burst, 1))}
+		l.buckets[client] = b
+	}
+	b.lastSeen = now
+	l.mu.Unlock()
+
+	reservation := b.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// forgetIdle drops idle buckets until ctx is cancelled; a full bucket is the
+// same as a new one
+func (l *rateLimiter) forgetIdle(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		l.mu.Lock()
+		for client, b := range l.buckets {
+			if time.Since(b.lastSeen) > bucketIdleTimeout {
+				delete(l.buckets, client)
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
+// edge identifies the client of each request (API key, user or IP) and
+// applies its rate limit and, for API keys, its daily quota
+var errInvalidAPIKey = apperr.Unauthenticated(apperr.ReasonInvalidAPIKey, "invalid API key")
+
+type edge struct {
+	config   RateLimitConfig
+	limiter  *rateLimiter
+	keys     *apiKeyRegistry
+	verifier authz.Verifier // Nil when no auth service is configured; users are then limited by IP
+}
+
+func (e *edge) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only the gateway says which API key a request came with
+		r.Header.Del("X-Api-Key-Id")
+
+		if raw := r.Header.Get("X-Api-Key"); raw != "" {
+			key, ok := e.keys.lookup(raw)
+			if !ok {
+				writeProblem(w, r, errInvalidAPIKey)
+				return
+			}
+			r.Header.Del("X-Api-Key")
+			r.Header.Set("X-Api-Key-Id", key.ID)
+
+			if allowed, wait := e.limiter.allow("key:"+key.ID, key.Rate); !allowed {
+				tooManyRequests(w, r, wait, "rate limit exceeded for this API key")
+				return
+			}
+			if !e.keys.consume(w, r, key) {
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		client, bucket := "ip:"+e.clientIP(r), e.config.Anonymous
+		if userID := e.userID(r); userID != "" {
+			client, bucket = "user:"+userID, e.config.Authenticated
+		}
+		if allowed, wait := e.limiter.allow(client, bucket); !allowed {
+			tooManyRequests(w, r, wait, "too many requests")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// userID is the subject of a valid bearer token. Unverified tokens don't
+// count: anyone could mint new subjects to dodge their limit.
+func (e *edge) userID(r *http.Request) string {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || e.verifier == nil {
+		return ""
+	}
+	claims, err := e.verifier.Verify(r.Context(), strings.TrimSpace(token))
+	if err != nil {
+		return ""
+	}
+	return claims.UserID()
+}
+
+func (e *edge) clientIP(r *http.Request) string {
+	if e.config.TrustForwardedFor {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			entries := strings.Split(forwarded, ",")
+			return strings.TrimSpace(entries[len(entries)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// tooManyRequests answers 429 with Retry-After in whole seconds
+func tooManyRequests(w http.ResponseWriter, r *http.Request, wait time.Duration, message string) {
+	writeProblem(w, r, apperr.ResourceExhausted(apperr.ReasonRateLimited, message, wait))
+}
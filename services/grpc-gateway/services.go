@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/user"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// registrar mounts a service's REST routes on the mux, calling it over conn
+type registrar func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+// service is a backend the gateway knows: its REST routes, and the full name
+// of its gRPC service so streaming methods can be reached over WebSocket
+type service struct {
+	register registrar
+	proto    protoreflect.FullName
+}
+
+// registry lists the services the gateway can route to, by their name in the
+// config. A new backend (quiz, question bank, leaderboard, ...) is added here
+// once its proto has HTTP annotations.
+var registry = map[string]service{
+	"auth": {auth.RegisterAuthServiceHandler, "auth.AuthService"},
+	"user": {user.RegisterUserServiceHandler, "user.UserService"},
+}
+
+func knownServices() string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
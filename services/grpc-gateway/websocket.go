@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"github.com/coder/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+type WebSocketConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	PingInterval    time.Duration `yaml:"ping_interval"` // Clients that don't answer a ping within this long are dropped
+	WriteTimeout    time.Duration `yaml:"write_timeout"` // Clients too slow to take a message within this long are dropped
+	MaxMessageBytes int64         `yaml:"max_message_bytes"`
+}
+
+// Same JSON as the REST routes (grpc-gateway's default marshaler)
+var (
+	wsMarshaler   = protojson.MarshalOptions{EmitUnpopulated: true}
+	wsUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// wsBridge serves streaming RPCs at /ws/{service}/{method}, which REST can
+// only offer as newline-delimited JSON.
+//
+// Every text message from the client is one request in JSON; for
+// server-streaming methods only the first is read. An empty message ends the
+// client's side of the stream. Every response is sent as {"result": ...}; when
+// the call fails, {"error": {"code", "message", "details"}} is sent and the
+// socket closes with 4000 + the gRPC code, otherwise it closes normally.
+//
+// A response is only read from the service once the client has taken the
+// previous one, and a request is only read from the client once the service
+// has accepted the previous one, so gRPC flow control pushes back on both ends.
+type wsBridge struct {
+	config    WebSocketConfig
+	conns     map[string]*grpc.ClientConn
+	verifier  authz.Verifier // Nil when no auth service is configured; the services still verify the token
+	origins   []string
+	anyOrigin bool
+	shutdown  context.Context // Sockets are closed when it's done
+}
+
+func newWSBridge(ctx context.Context, config WebSocketConfig, cors CORSConfig, conns map[string]*grpc.ClientConn, verifier authz.Verifier) *wsBridge {
+	b := &wsBridge{config: config, conns: conns, verifier: verifier, shutdown: ctx}
+	// Browsers may open sockets from the same origins as they may call the API
+	for _, origin := range cors.AllowedOrigins {
+		if origin == "*" {
+			b.anyOrigin = true
+			continue
+		}
+		if u, err := url.Parse(origin); err == nil && u.Host != "" {
+			b.origins = append(b.origins, u.Host)
+		}
+	}
+	return b
+}
+
+func (b *wsBridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method, conn, ok := b.method(r.PathValue("service"), r.PathValue("method"))
+	if !ok {
+		writeProblem(w, r, apperr.NotFound(apperr.ReasonNotFound, "no such service method"))
+		return
+	}
+	if !method.IsStreamingClient() && !method.IsStreamingServer() {
+		writeProblem(w, r, apperr.InvalidArgument(apperr.ReasonInvalidArgument, "unary methods are served over REST, not WebSocket"))
+		return
+	}
+
+	// ✅ Check the token before upgrading, so a bad one gets a plain 401
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	token = strings.TrimSpace(token)
+	if !ok || token == "" {
+		writeProblem(w, r, apperr.Unauthenticated(apperr.ReasonAuthenticationRequired, "missing access token"))
+		return
+	}
+	if b.verifier != nil {
+		if _, err := b.verifier.Verify(r.Context(), token); err != nil {
+			writeProblem(w, r, apperr.Unauthenticated(apperr.ReasonInvalidAccessToken, "invalid access token"))
+			return
+		}
+	}
+
+	c, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: b.origins, InsecureSkipVerify: b.anyOrigin})
+	if err != nil {
+		return // Accept has answered the request
+	}
+	defer c.CloseNow()
+	c.SetReadLimit(b.config.MaxMessageBytes)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	stop := context.AfterFunc(b.shutdown, func() {
+		c.Close(websocket.StatusGoingAway, "gateway shutting down")
+	})
+	defer stop()
+
+	// Same metadata as headerMatcher forwards for REST calls
+	md := metadata.Pairs("authorization", "Bearer "+token, "x-request-id", r.Header.Get("X-Request-Id"))
+	if keyID := r.Header.Get("X-Api-Key-Id"); keyID != "" {
+		md.Set("x-api-key-id", keyID)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	desc := &grpc.StreamDesc{
+		StreamName:    string(method.Name()),
+		ServerStreams: method.IsStreamingServer(),
+		ClientStreams: method.IsStreamingClient(),
+	}
+	stream, err := conn.NewStream(ctx, desc, fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name()))
+	if err != nil {
+		b.fail(ctx, c, status.Convert(err))
+		return
+	}
+
+	go b.keepalive(ctx, c)
+	go b.forwardRequests(ctx, cancel, c, stream, method)
+	b.forwardResponses(ctx, c, stream, method)
+}
+
+// method finds a streaming method by the service's config name and the RPC name
+func (b *wsBridge) method(serviceName, methodName string) (protoreflect.MethodDescriptor, *grpc.ClientConn, bool) {
+	conn, ok := b.conns[serviceName]
+	if !ok {
+		return nil, nil, false
+	}
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(registry[serviceName].proto)
+	if err != nil {
+		return nil, nil, false
+	}
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, nil, false
+	}
+	method := service.Methods().ByName(protoreflect.Name(methodName))
+	return method, conn, method != nil
+}
+
+// forwardRequests sends the client's messages to the service. When the client
+// is done sending, it keeps reading so pings are answered and a closed socket
+// cancels the call.
+func (b *wsBridge) forwardRequests(ctx context.Context, cancel context.CancelFunc, c *websocket.Conn, stream grpc.ClientStream, method protoreflect.MethodDescriptor) {
+	for {
+		typ, data, err := c.Read(ctx)
+		if err != nil {
+			cancel() // The client went away
+			return
+		}
+		if typ != websocket.MessageText {
+			c.Close(websocket.StatusUnsupportedData, "send requests as JSON text messages")
+			cancel()
+			return
+		}
+		if len(data) == 0 {
+			break
+		}
+		msg := newMessage(method.Input())
+		if err := wsUnmarshaler.Unmarshal(data, msg); err != nil {
+			b.fail(ctx, c, status.New(codes.InvalidArgument, err.Error()))
+			cancel()
+			return
+		}
+		if err := stream.SendMsg(msg); err != nil {
+			return // The call is over; forwardResponses reports its status
+		}
+		if !method.IsStreamingClient() {
+			break
+		}
+	}
+	stream.CloseSend()
+	context.AfterFunc(c.CloseRead(ctx), cancel)
+}
+
+// forwardResponses sends the service's responses to the client until the call ends
+func (b *wsBridge) forwardResponses(ctx context.Context, c *websocket.Conn, stream grpc.ClientStream, method protoreflect.MethodDescriptor) {
+	for {
+		msg := newMessage(method.Output())
+		if err := stream.RecvMsg(msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				c.Close(websocket.StatusNormalClosure, "")
+				return
+			}
+			b.fail(ctx, c, status.Convert(err))
+			return
+		}
+		data, err := wsMarshaler.Marshal(msg)
+		if err != nil {
+			b.fail(ctx, c, status.New(codes.Internal, "failed to encode response"))
+			return
+		}
+		if err := b.write(ctx, c, `{"result":`, data); err != nil {
+			return
+		}
+	}
+}
+
+// fail sends the error to the client and closes the socket with 4000 + its gRPC code
+func (b *wsBridge) fail(ctx context.Context, c *websocket.Conn, st *status.Status) {
+	if data, err := wsMarshaler.Marshal(st.Proto()); err == nil {
+		b.write(context.WithoutCancel(ctx), c, `{"error":`, data)
+	}
+	c.Close(websocket.StatusCode(4000+int(st.Code())), st.Code().String())
+}
+
+// write sends {"result": data} or {"error": data}, giving up on clients that
+// stop reading
+func (b *wsBridge) write(ctx context.Context, c *websocket.Conn, prefix string, data []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, b.config.WriteTimeout)
+	defer cancel()
+	frame := make([]byte, 0, len(prefix)+len(data)+1)
+	frame = append(append(append(frame, prefix...), data...), '}')
+	return c.Write(ctx, websocket.MessageText, frame)
+}
+
+// keepalive pings the client and drops it if a pong doesn't come back in time,
+// so half-open connections don't hold streams open
+func (b *wsBridge) keepalive(ctx context.Context, c *websocket.Conn) {
+	ticker := time.NewTicker(b.config.PingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		pingCtx, cancel := context.WithTimeout(ctx, b.config.PingInterval)
+		err := c.Ping(pingCtx)
+		cancel()
+		if err != nil {
+			c.CloseNow()
+			return
+		}
+	}
+}
+
+// newMessage makes an empty message of the given type, using the generated
+// Go type when the service's package is linked in
+func newMessage(desc protoreflect.MessageDescriptor) proto.Message {
+	if typ, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName()); err == nil {
+		return typ.New().Interface()
+	}
+	return dynamicpb.NewMessage(desc)
+}
+
+// withQueryToken accepts the access token as ?access_token=, since browsers
+// can't set headers on WebSocket requests. It's moved into the Authorization
+// header (and out of the URL, so it isn't logged) before rate limiting.
+func withQueryToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if token := query.Get("access_token"); token != "" {
+			if r.Header.Get("Authorization") == "" {
+				r.Header.Set("Authorization", "Bearer "+token)
+			}
+			query.Del("access_token")
+			r.URL.RawQuery = query.Encode()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
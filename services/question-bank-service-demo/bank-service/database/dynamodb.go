@@ -1,50 +1,122 @@
 package database
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
-	"time"
-
-	"github.com/google/uuid"
+	"sync"
 
 	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/models"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"golang.org/x/sync/errgroup"
 )
 
-var db *dynamodb.DynamoDB
+// DynamoConfig says where the tables are. Endpoint points the client at
+// DynamoDB Local (e.g. http://localhost:8000) instead of AWS.
+type DynamoConfig struct {
+	Region         string
+	Endpoint       string
+	QuestionsTable string
+	OptionsTable   string
+}
 
-func InitDynamoDB() {
-	sess := session.Must(session.NewSession(&aws.Config{
-		Region: aws.String("us-east-1"),
-	}))
-	db = dynamodb.New(sess)
-	fmt.Println("DynamoDB initialized")
+// DynamoConfigFromEnv reads AWS_REGION, DYNAMODB_ENDPOINT, QUESTIONS_TABLE and
+// OPTIONS_TABLE (the table names are set by QuestionBankStack)
+func DynamoConfigFromEnv() DynamoConfig {
+	config := DynamoConfig{
+		Region:         os.Getenv("AWS_REGION"),
+		Endpoint:       os.Getenv("DYNAMODB_ENDPOINT"),
+		QuestionsTable: os.Getenv("QUESTIONS_TABLE"),
+		OptionsTable:   os.Getenv("OPTIONS_TABLE"),
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.QuestionsTable == "" {
+		config.QuestionsTable = "QuestionsTable"
+	}
+	if config.OptionsTable == "" {
+		config.OptionsTable = "OptionsTable"
+	}
+	return config
 }
 
-// Question-related functions
+// DynamoStore keeps questions and options in the tables of QuestionBankStack
+type DynamoStore struct {
+	db     dynamodbiface.DynamoDBAPI
+	config DynamoConfig
+}
 
-// SaveQuestion saves a question to the database
-func SaveQuestion(question models.Question) error {
-	tableName := os.Getenv("QUESTIONS_TABLE")
-	if tableName == "" {
-		tableName = "QuestionsTable"
+func NewDynamoStore(config DynamoConfig) (*DynamoStore, error) {
+	awsConfig := &aws.Config{Region: aws.String(config.Region)}
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+		// DynamoDB Local accepts any credentials, but the SDK wants some
+		if os.Getenv("AWS_ACCESS_KEY_ID") == "" {
+			awsConfig.Credentials = credentials.NewStaticCredentials("local", "local", "")
+		}
 	}
-
-	if question.QuestionID == "" {
-		question.QuestionID = uuid.New().String()
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
 	}
+	fmt.Println("DynamoDB initialized")
+	return &DynamoStore{db: dynamodb.New(sess), config: config}, nil
+}
 
-	now := time.Now()
-	if question.CreatedAt.IsZero() {
-		question.CreatedAt = now
+// CreateTables creates the tables and indexes QuestionBankStack defines, for
+// DynamoDB Local. Existing tables are left alone.
+func (s *DynamoStore) CreateTables(ctx context.Context) error {
+	tables := []struct{ name, key, indexName, indexKey string }{
+		{s.config.QuestionsTable, "question_id", "quizIndex", "quiz_id"},
+		{s.config.OptionsTable, "option_id", "questionIndex", "question_id"},
+	}
+	for _, table := range tables {
+		input := &dynamodb.CreateTableInput{
+			TableName:   aws.String(table.name),
+			BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: aws.String(table.key), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+				{AttributeName: aws.String(table.indexKey), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			},
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String(table.key), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			},
+			GlobalSecondaryIndexes: []*dynamodb.GlobalSecondaryIndex{{
+				IndexName: aws.String(table.indexName),
+				KeySchema: []*dynamodb.KeySchemaElement{
+					{AttributeName: aws.String(table.indexKey), KeyType: aws.String(dynamodb.KeyTypeHash)},
+				},
+				Projection: &dynamodb.Projection{ProjectionType: aws.String(dynamodb.ProjectionTypeAll)},
+			}},
+		}
+		_, err := s.db.CreateTableWithContext(ctx, input)
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == dynamodb.ErrCodeResourceInUseException {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create table %s: %w", table.name, err)
+		}
 	}
-	question.UpdatedAt = now
+	return nil
+}
+
+// Question-related functions
+
+// SaveQuestion saves a question to the database
+func (s *DynamoStore) SaveQuestion(ctx context.Context, question *models.Question) error {
+	prepareQuestion(question)
 
 	av, err := dynamodbattribute.MarshalMap(question)
 	if err != nil {
@@ -53,11 +125,11 @@ func SaveQuestion(question models.Question) error {
 	}
 
 	input := &dynamodb.PutItemInput{
-		TableName: aws.String(tableName),
+		TableName: aws.String(s.config.QuestionsTable),
 		Item:      av,
 	}
 
-	_, err = db.PutItem(input)
+	_, err = s.db.PutItemWithContext(ctx, input)
 	if err != nil {
 		log.Printf("Error putting item in DynamoDB: %v", err)
 		return err
@@ -67,26 +139,21 @@ func SaveQuestion(question models.Question) error {
 }
 
 // GetQuestionByID retrieves a question by its ID
-func GetQuestionByID(questionID string) (*models.Question, error) {
-	tableName := os.Getenv("QUESTIONS_TABLE")
-	if tableName == "" {
-		tableName = "QuestionsTable"
-	}
-
+func (s *DynamoStore) GetQuestionByID(ctx context.Context, questionID string) (*models.Question, error) {
 	input := &dynamodb.GetItemInput{
-		TableName: aws.String(tableName),
+		TableName: aws.String(s.config.QuestionsTable),
 		Key: map[string]*dynamodb.AttributeValue{
 			"question_id": {S: aws.String(questionID)},
 		},
 	}
 
-	result, err := db.GetItem(input)
+	result, err := s.db.GetItemWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 
 	if result.Item == nil {
-		return nil, fmt.Errorf("question not found")
+		return nil, ErrQuestionNotFound
 	}
 
 	question := &models.Question{}
@@ -99,20 +166,15 @@ func GetQuestionByID(questionID string) (*models.Question, error) {
 }
 
 // DeleteQuestion deletes a question by its ID
-func DeleteQuestion(questionID string) error {
-	tableName := os.Getenv("QUESTIONS_TABLE")
-	if tableName == "" {
-		tableName = "QuestionsTable"
-	}
-
+func (s *DynamoStore) DeleteQuestion(ctx context.Context, questionID string) error {
 	input := &dynamodb.DeleteItemInput{
-		TableName: aws.String(tableName),
+		TableName: aws.String(s.config.QuestionsTable),
 		Key: map[string]*dynamodb.AttributeValue{
 			"question_id": {S: aws.String(questionID)},
 		},
 	}
 
-	_, err := db.DeleteItem(input)
+	_, err := s.db.DeleteItemWithContext(ctx, input)
 	if err != nil {
 		return err
 	}
@@ -120,53 +182,71 @@ func DeleteQuestion(questionID string) error {
 	return nil
 }
 
-// GetQuestionsByQuizID retrieves all questions for a given quiz
-func GetQuestionsByQuizID(quizID string) ([]models.Question, error) {
-	tableName := os.Getenv("QUESTIONS_TABLE")
-	if tableName == "" {
-		tableName = "QuestionsTable"
+// GetQuestionsByQuizID queries quizIndex for one page of the quiz's
+// questions. The page token holds the last question handed out, which
+// together with the quiz ID is the index key DynamoDB continues after.
+func (s *DynamoStore) GetQuestionsByQuizID(ctx context.Context, quizID string, page PageRequest) (QuestionPage, error) {
+	after, err := decodePageToken(page.Token)
+	if err != nil {
+		return QuestionPage{}, err
 	}
+	size := page.pageSize()
 
-	// Create the expression for the filter
-	filt := expression.Name("quiz_id").Equal(expression.Value(quizID))
-	expr, err := expression.NewBuilder().WithFilter(filt).Build()
+	keyCond := expression.Key("quiz_id").Equal(expression.Value(quizID))
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCond).Build()
 	if err != nil {
-		return nil, err
+		return QuestionPage{}, err
 	}
 
-	input := &dynamodb.ScanInput{
-		TableName:                 aws.String(tableName),
-		FilterExpression:          expr.Filter(),
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(s.config.QuestionsTable),
+		IndexName:                 aws.String("quizIndex"),
+		KeyConditionExpression:    expr.KeyCondition(),
 		ExpressionAttributeNames:  expr.Names(),
 		ExpressionAttributeValues: expr.Values(),
 	}
+	if after != "" {
+		input.ExclusiveStartKey = map[string]*dynamodb.AttributeValue{
+			"quiz_id":     {S: aws.String(quizID)},
+			"question_id": {S: aws.String(after)},
+		}
+	}
 
-	result, err := db.Scan(input)
-	if err != nil {
-		return nil, err
+	// Ask for one more than the page to know whether there's another one.
+	// A query stops at 1 MB, so it can take a few round trips to get there.
+	var items []map[string]*dynamodb.AttributeValue
+	for {
+		input.Limit = aws.Int64(int64(size + 1 - len(items)))
+		result, err := s.db.QueryWithContext(ctx, input)
+		if err != nil {
+			return QuestionPage{}, err
+		}
+		items = append(items, result.Items...)
+		if len(items) > size || result.LastEvaluatedKey == nil {
+			break
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
 	}
 
 	questions := []models.Question{}
-	err = dynamodbattribute.UnmarshalListOfMaps(result.Items, &questions)
+	err = dynamodbattribute.UnmarshalListOfMaps(items, &questions)
 	if err != nil {
-		return nil, err
+		return QuestionPage{}, err
 	}
 
-	return questions, nil
+	result := QuestionPage{Questions: questions}
+	if len(questions) > size {
+		result.Questions = questions[:size]
+		result.NextPageToken = encodePageToken(questions[size-1].QuestionID)
+	}
+	return result, nil
 }
 
 // Option-related functions
 
 // SaveOption saves an option to the database
-func SaveOption(option models.Option) error {
-	tableName := os.Getenv("OPTIONS_TABLE")
-	if tableName == "" {
-		tableName = "OptionsTable"
-	}
-
-	if option.OptionID == "" {
-		option.OptionID = uuid.New().String()
-	}
+func (s *DynamoStore) SaveOption(ctx context.Context, option *models.Option) error {
+	prepareOption(option)
 
 	av, err := dynamodbattribute.MarshalMap(option)
 	if err != nil {
@@ -175,11 +255,11 @@ func SaveOption(option models.Option) error {
 	}
 
 	input := &dynamodb.PutItemInput{
-		TableName: aws.String(tableName),
+		TableName: aws.String(s.config.OptionsTable),
 		Item:      av,
 	}
 
-	_, err = db.PutItem(input)
+	_, err = s.db.PutItemWithContext(ctx, input)
 	if err != nil {
 		log.Printf("Error putting item in DynamoDB: %v", err)
 		return err
@@ -188,34 +268,33 @@ func SaveOption(option models.Option) error {
 	return nil
 }
 
-// GetOptionsByQuestionID retrieves all options for a given question
-func GetOptionsByQuestionID(questionID string) ([]models.Option, error) {
-	tableName := os.Getenv("OPTIONS_TABLE")
-	if tableName == "" {
-		tableName = "OptionsTable"
-	}
-
-	// Create the expression for the filter
-	filt := expression.Name("question_id").Equal(expression.Value(questionID))
-	expr, err := expression.NewBuilder().WithFilter(filt).Build()
+// GetOptionsByQuestionID queries questionIndex for all options of a question
+func (s *DynamoStore) GetOptionsByQuestionID(ctx context.Context, questionID string) ([]models.Option, error) {
+	keyCond := expression.Key("question_id").Equal(expression.Value(questionID))
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCond).Build()
 	if err != nil {
 		return nil, err
 	}
 
-	input := &dynamodb.ScanInput{
-		TableName:                 aws.String(tableName),
-		FilterExpression:          expr.Filter(),
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(s.config.OptionsTable),
+		IndexName:                 aws.String("questionIndex"),
+		KeyConditionExpression:    expr.KeyCondition(),
 		ExpressionAttributeNames:  expr.Names(),
 		ExpressionAttributeValues: expr.Values(),
 	}
 
-	result, err := db.Scan(input)
+	var items []map[string]*dynamodb.AttributeValue
+	err = s.db.QueryPagesWithContext(ctx, input, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		items = append(items, page.Items...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	options := []models.Option{}
-	err = dynamodbattribute.UnmarshalListOfMaps(result.Items, &options)
+	err = dynamodbattribute.UnmarshalListOfMaps(items, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -223,27 +302,53 @@ func GetOptionsByQuestionID(questionID string) ([]models.Option, error) {
 	return options, nil
 }
 
+// optionQueryConcurrency caps the questionIndex queries GetOptionsByQuestionIDs
+// runs at once
+const optionQueryConcurrency = 8
+
+// GetOptionsByQuestionIDs queries questionIndex for each question in
+// parallel. BatchGetItem can't help here: it needs the option IDs.
+func (s *DynamoStore) GetOptionsByQuestionIDs(ctx context.Context, questionIDs []string) (map[string][]models.Option, error) {
+	var mu sync.Mutex
+	options := map[string][]models.Option{}
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(optionQueryConcurrency)
+	for _, questionID := range questionIDs {
+		group.Go(func() error {
+			questionOptions, err := s.GetOptionsByQuestionID(ctx, questionID)
+			if err != nil || len(questionOptions) == 0 {
+				return err
+			}
+			mu.Lock()
+			options[questionID] = questionOptions
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
 // DeleteOptionsByQuestionID deletes all options for a given question
-func DeleteOptionsByQuestionID(questionID string) error {
-	options, err := GetOptionsByQuestionID(questionID)
+func (s *DynamoStore) DeleteOptionsByQuestionID(ctx context.Context, questionID string) error {
+	options, err := s.GetOptionsByQuestionID(ctx, questionID)
 	if err != nil {
 		return err
 	}
 
-	tableName := os.Getenv("OPTIONS_TABLE")
-	if tableName == "" {
-		tableName = "OptionsTable"
-	}
-
 	for _, option := range options {
 		input := &dynamodb.DeleteItemInput{
-			TableName: aws.String(tableName),
+			TableName: aws.String(s.config.OptionsTable),
 			Key: map[string]*dynamodb.AttributeValue{
 				"option_id": {S: aws.String(option.OptionID)},
 			},
 		}
 
-		_, err := db.DeleteItem(input)
+		_, err := s.db.DeleteItemWithContext(ctx, input)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,46 @@
+package database_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database"
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database/repositorytest"
+)
+
+// Runs against DynamoDB Local, e.g.
+//
+//	docker run -p 8000:8000 amazon/dynamodb-local
+//	DYNAMODB_ENDPOINT=http://localhost:8000 go test ./bank-service/database
+func TestDynamoStore(t *testing.T) {
+	endpoint := os.Getenv("DYNAMODB_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("DYNAMODB_ENDPOINT is not set")
+	}
+
+	repositorytest.Run(t, func(t *testing.T) database.Store {
+		// Fresh tables for every test, so they can't see each other's items
+		suffix := uuid.New().String()[:8]
+		store, err := database.NewDynamoStore(database.DynamoConfig{
+			Region:         "us-east-1",
+			Endpoint:       endpoint,
+			QuestionsTable: "Questions-" + suffix,
+			OptionsTable:   "Options-" + suffix,
+		})
+		if err != nil {
+			t.Fatalf("NewDynamoStore: %v", err)
+		}
+		if err := store.CreateTables(context.Background()); err != nil {
+			t.Fatalf("CreateTables: %v", err)
+		}
+		t.Cleanup(func() {
+			if err := store.DeleteTables(context.Background()); err != nil {
+				t.Errorf("DeleteTables: %v", err)
+			}
+		})
+		return store
+	})
+}
@@ -0,0 +1,24 @@
+package database
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// Test-only helpers for the backend tests in database_test
+
+func (s *DynamoStore) DeleteTables(ctx context.Context) error {
+	for _, table := range []string{s.config.QuestionsTable, s.config.OptionsTable} {
+		if _, err := s.db.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(table)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *PostgresStore) Truncate(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, `TRUNCATE questions, options`)
+	return err
+}
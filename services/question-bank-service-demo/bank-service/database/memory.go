@@ -0,0 +1,124 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/models"
+)
+
+// MemoryStore keeps everything in maps, for tests and running offline.
+// Nothing survives a restart.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	questions map[string]models.Question
+	options   map[string]models.Option
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		questions: map[string]models.Question{},
+		options:   map[string]models.Option{},
+	}
+}
+
+func (s *MemoryStore) SaveQuestion(ctx context.Context, question *models.Question) error {
+	prepareQuestion(question)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.questions[question.QuestionID] = *question
+	return nil
+}
+
+func (s *MemoryStore) GetQuestionByID(ctx context.Context, questionID string) (*models.Question, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	question, ok := s.questions[questionID]
+	if !ok {
+		return nil, ErrQuestionNotFound
+	}
+	return &question, nil
+}
+
+func (s *MemoryStore) DeleteQuestion(ctx context.Context, questionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.questions, questionID)
+	return nil
+}
+
+// GetQuestionsByQuizID pages through the quiz's questions by ID
+func (s *MemoryStore) GetQuestionsByQuizID(ctx context.Context, quizID string, page PageRequest) (QuestionPage, error) {
+	after, err := decodePageToken(page.Token)
+	if err != nil {
+		return QuestionPage{}, err
+	}
+
+	s.mu.RLock()
+	questions := []models.Question{}
+	for _, question := range s.questions {
+		if question.QuizID == quizID && question.QuestionID > after {
+			questions = append(questions, question)
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(questions, func(i, j int) bool { return questions[i].QuestionID < questions[j].QuestionID })
+	result := QuestionPage{Questions: questions}
+	if size := page.pageSize(); len(questions) > size {
+		result.Questions = questions[:size]
+		result.NextPageToken = encodePageToken(questions[size-1].QuestionID)
+	}
+	return result, nil
+}
+
+func (s *MemoryStore) SaveOption(ctx context.Context, option *models.Option) error {
+	prepareOption(option)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.options[option.OptionID] = *option
+	return nil
+}
+
+func (s *MemoryStore) GetOptionsByQuestionID(ctx context.Context, questionID string) ([]models.Option, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	options := []models.Option{}
+	for _, option := range s.options {
+		if option.QuestionID == questionID {
+			options = append(options, option)
+		}
+	}
+	return options, nil
+}
+
+func (s *MemoryStore) GetOptionsByQuestionIDs(ctx context.Context, questionIDs []string) (map[string][]models.Option, error) {
+	wanted := make(map[string]bool, len(questionIDs))
+	for _, id := range questionIDs {
+		wanted[id] = true
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	options := map[string][]models.Option{}
+	for _, option := range s.options {
+		if wanted[option.QuestionID] {
+			options[option.QuestionID] = append(options[option.QuestionID], option)
+		}
+	}
+	return options, nil
+}
+
+func (s *MemoryStore) DeleteOptionsByQuestionID(ctx context.Context, questionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, option := range s.options {
+		if option.QuestionID == questionID {
+			delete(s.options, id)
+		}
+	}
+	return nil
+}
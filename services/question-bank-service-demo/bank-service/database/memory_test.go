@@ -0,0 +1,14 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database"
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database/repositorytest"
+)
+
+func TestMemoryStore(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) database.Store {
+		return database.NewMemoryStore()
+	})
+}
@@ -0,0 +1,214 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/models"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // Registers the "pgx" driver
+)
+
+const postgresSchema = `
+CREATE TABLE IF NOT EXISTS questions (
+	question_id   TEXT PRIMARY KEY,
+	quiz_id       TEXT NOT NULL,
+	question_text TEXT NOT NULL,
+	question_type TEXT NOT NULL,
+	answer        TEXT NOT NULL DEFAULT '',
+	created_at    TIMESTAMPTZ NOT NULL,
+	updated_at    TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS questions_quiz_id_question_id_idx ON questions (quiz_id, question_id);
+
+CREATE TABLE IF NOT EXISTS options (
+	option_id   TEXT PRIMARY KEY,
+	question_id TEXT NOT NULL,
+	option_text TEXT NOT NULL,
+	is_correct  BOOLEAN NOT NULL,
+	created_at  TIMESTAMPTZ NOT NULL,
+	updated_at  TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS options_question_id_idx ON options (question_id);
+`
+
+// PostgresStore keeps questions and options in Postgres, for deployments
+// outside AWS
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// OpenPostgresStore connects to databaseURL and creates the tables if needed
+func OpenPostgresStore(ctx context.Context, databaseURL string) (*PostgresStore, error) {
+	if databaseURL == "" {
+		return nil, errors.New("DATABASE_URL is required for the postgres store")
+	}
+	db, err := sql.Open("pgx", databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.ExecContext(ctx, postgresSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create tables: %w", err)
+	}
+	return &PostgresStore{db: db}, nil
+}
+
+func (s *PostgresStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *PostgresStore) SaveQuestion(ctx context.Context, question *models.Question) error {
+	prepareQuestion(question)
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO questions (question_id, quiz_id, question_text, question_type, answer, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (question_id) DO UPDATE SET
+			quiz_id = EXCLUDED.quiz_id,
+			question_text = EXCLUDED.question_text,
+			question_type = EXCLUDED.question_type,
+			answer = EXCLUDED.answer,
+			created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at`,
+		question.QuestionID, question.QuizID, question.QuestionText, question.QuestionType, question.Answer, question.CreatedAt, question.UpdatedAt)
+	return err
+}
+
+func (s *PostgresStore) GetQuestionByID(ctx context.Context, questionID string) (*models.Question, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT question_id, quiz_id, question_text, question_type, answer, created_at, updated_at
+		FROM questions WHERE question_id = $1`, questionID)
+	question, err := scanQuestion(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrQuestionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &question, nil
+}
+
+func (s *PostgresStore) DeleteQuestion(ctx context.Context, questionID string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM questions WHERE question_id = $1`, questionID)
+	return err
+}
+
+// GetQuestionsByQuizID reads one row past the page to know whether there's
+// another one
+func (s *PostgresStore) GetQuestionsByQuizID(ctx context.Context, quizID string, page PageRequest) (QuestionPage, error) {
+	after, err := decodePageToken(page.Token)
+	if err != nil {
+		return QuestionPage{}, err
+	}
+	size := page.pageSize()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT question_id, quiz_id, question_text, question_type, answer, created_at, updated_at
+		FROM questions WHERE quiz_id = $1 AND question_id > $2
+		ORDER BY question_id LIMIT $3`, quizID, after, size+1)
+	if err != nil {
+		return QuestionPage{}, err
+	}
+	defer rows.Close()
+
+	questions := []models.Question{}
+	for rows.Next() {
+		question, err := scanQuestion(rows)
+		if err != nil {
+			return QuestionPage{}, err
+		}
+		questions = append(questions, question)
+	}
+	if err := rows.Err(); err != nil {
+		return QuestionPage{}, err
+	}
+
+	result := QuestionPage{Questions: questions}
+	if len(questions) > size {
+		result.Questions = questions[:size]
+		result.NextPageToken = encodePageToken(questions[size-1].QuestionID)
+	}
+	return result, nil
+}
+
+func (s *PostgresStore) SaveOption(ctx context.Context, option *models.Option) error {
+	prepareOption(option)
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO options (option_id, question_id, option_text, is_correct, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (option_id) DO UPDATE SET
+			question_id = EXCLUDED.question_id,
+			option_text = EXCLUDED.option_text,
+			is_correct = EXCLUDED.is_correct,
+			created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at`,
+		option.OptionID, option.QuestionID, option.OptionText, option.IsCorrect, option.CreatedAt, option.UpdatedAt)
+	return err
+}
+
+func (s *PostgresStore) GetOptionsByQuestionID(ctx context.Context, questionID string) ([]models.Option, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT option_id, question_id, option_text, is_correct, created_at, updated_at
+		FROM options WHERE question_id = $1`, questionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	options := []models.Option{}
+	for rows.Next() {
+		option, err := scanOption(rows)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+	return options, rows.Err()
+}
+
+func (s *PostgresStore) GetOptionsByQuestionIDs(ctx context.Context, questionIDs []string) (map[string][]models.Option, error) {
+	options := map[string][]models.Option{}
+	if len(questionIDs) == 0 {
+		return options, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT option_id, question_id, option_text, is_correct, created_at, updated_at
+		FROM options WHERE question_id = ANY($1)`, questionIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		option, err := scanOption(rows)
+		if err != nil {
+			return nil, err
+		}
+		options[option.QuestionID] = append(options[option.QuestionID], option)
+	}
+	return options, rows.Err()
+}
+
+func (s *PostgresStore) DeleteOptionsByQuestionID(ctx context.Context, questionID string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM options WHERE question_id = $1`, questionID)
+	return err
+}
+
+func scanQuestion(row interface{ Scan(...interface{}) error }) (models.Question, error) {
+	var question models.Question
+	err := row.Scan(&question.QuestionID, &question.QuizID, &question.QuestionText, &question.QuestionType, &question.Answer, &question.CreatedAt, &question.UpdatedAt)
+	question.CreatedAt, question.UpdatedAt = question.CreatedAt.UTC(), question.UpdatedAt.UTC()
+	return question, err
+}
+
+func scanOption(row interface{ Scan(...interface{}) error }) (models.Option, error) {
+	var option models.Option
+	err := row.Scan(&option.OptionID, &option.QuestionID, &option.OptionText, &option.IsCorrect, &option.CreatedAt, &option.UpdatedAt)
+	option.CreatedAt, option.UpdatedAt = option.CreatedAt.UTC(), option.UpdatedAt.UTC()
+	return option, err
+}
@@ -0,0 +1,32 @@
+package database_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database"
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database/repositorytest"
+)
+
+// Runs against a throwaway database, whose tables it empties, e.g.
+//
+//	QUESTION_BANK_TEST_DATABASE_URL=postgres://postgres@localhost/question_bank_test go test ./bank-service/database
+func TestPostgresStore(t *testing.T) {
+	databaseURL := os.Getenv("QUESTION_BANK_TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("QUESTION_BANK_TEST_DATABASE_URL is not set")
+	}
+
+	repositorytest.Run(t, func(t *testing.T) database.Store {
+		store, err := database.OpenPostgresStore(context.Background(), databaseURL)
+		if err != nil {
+			t.Fatalf("OpenPostgresStore: %v", err)
+		}
+		t.Cleanup(func() { store.Close() })
+		if err := store.Truncate(context.Background()); err != nil {
+			t.Fatalf("Truncate: %v", err)
+		}
+		return store
+	})
+}
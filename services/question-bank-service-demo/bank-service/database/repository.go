@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/models"
+)
+
+var (
+	// ErrQuestionNotFound is returned by GetQuestionByID for unknown IDs
+	ErrQuestionNotFound = errors.New("question not found")
+	// ErrInvalidPageToken is returned for page tokens no store handed out
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+// Page sizes of GetQuestionsByQuizID
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 100
+)
+
+// PageRequest asks for up to Size items after the page that returned Token;
+// the zero PageRequest is the first DefaultPageSize items
+type PageRequest struct {
+	Size  int
+	Token string
+}
+
+// QuestionPage is one page of a quiz's questions. NextPageToken is empty on
+// the last page.
+type QuestionPage struct {
+	Questions     []models.Question
+	NextPageToken string
+}
+
+// QuestionRepository stores questions. Every backend must pass the suite in
+// database/repositorytest.
+type QuestionRepository interface {
+	// SaveQuestion creates or replaces the question, filling in a missing
+	// QuestionID and CreatedAt and setting UpdatedAt
+	SaveQuestion(ctx context.Context, question *models.Question) error
+	// GetQuestionByID returns ErrQuestionNotFound for unknown IDs
+	GetQuestionByID(ctx context.Context, questionID string) (*models.Question, error)
+	// DeleteQuestion succeeds for unknown IDs too
+	DeleteQuestion(ctx context.Context, questionID string) error
+	// GetQuestionsByQuizID returns one page of the quiz's questions. Paging
+	// through with NextPageToken returns every question once, in a stable
+	// order.
+	GetQuestionsByQuizID(ctx context.Context, quizID string, page PageRequest) (QuestionPage, error)
+}
+
+// OptionRepository stores the answer choices of questions
+type OptionRepository interface {
+	// SaveOption creates or replaces the option, filling in a missing OptionID
+	SaveOption(ctx context.Context, option *models.Option) error
+	GetOptionsByQuestionID(ctx context.Context, questionID string) ([]models.Option, error)
+	// GetOptionsByQuestionIDs fetches the options of many questions at once,
+	// keyed by question ID; questions without options are left out
+	GetOptionsByQuestionIDs(ctx context.Context, questionIDs []string) (map[string][]models.Option, error)
+	DeleteOptionsByQuestionID(ctx context.Context, questionID string) error
+}
+
+// Store is a backend holding both questions and options
+type Store interface {
+	QuestionRepository
+	OptionRepository
+}
+
+// Open returns the store named by QUESTION_BANK_STORE: "dynamodb" (the
+// default), "postgres" or "memory"
+func Open(ctx context.Context) (Store, error) {
+	switch backend := os.Getenv("QUESTION_BANK_STORE"); backend {
+	case "", "dynamodb":
+		return NewDynamoStore(DynamoConfigFromEnv())
+	case "postgres":
+		return OpenPostgresStore(ctx, os.Getenv("DATABASE_URL"))
+	case "memory":
+		return NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown QUESTION_BANK_STORE %q", backend)
+	}
+}
+
+// pageSize is the size asked for, within 1..MaxPageSize
+func (p PageRequest) pageSize() int {
+	if p.Size <= 0 {
+		return DefaultPageSize
+	}
+	return min(p.Size, MaxPageSize)
+}
+
+// Page tokens carry the ID of the last question of the previous page, which
+// is all any backend needs to continue after it. They're opaque to clients.
+type pageCursor struct {
+	QuestionID string `json:"q"`
+}
+
+func encodePageToken(questionID string) string {
+	data, _ := json.Marshal(pageCursor{QuestionID: questionID})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken returns the question ID to start after; "" for the first page
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", ErrInvalidPageToken
+	}
+	var cursor pageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.QuestionID == "" {
+		return "", ErrInvalidPageToken
+	}
+	return cursor.QuestionID, nil
+}
+
+// Timestamps are kept to the microsecond, the finest Postgres stores, so every
+// backend returns exactly what it was given
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+func prepareQuestion(question *models.Question) {
+	if question.QuestionID == "" {
+		question.QuestionID = uuid.New().String()
+	}
+	if question.CreatedAt.IsZero() {
+		question.CreatedAt = now()
+	}
+	question.CreatedAt = question.CreatedAt.UTC().Truncate(time.Microsecond)
+	question.UpdatedAt = now()
+}
+
+func prepareOption(option *models.Option) {
+	if option.OptionID == "" {
+		option.OptionID = uuid.New().String()
+	}
+	option.CreatedAt = option.CreatedAt.UTC().Truncate(time.Microsecond)
+	option.UpdatedAt = option.UpdatedAt.UTC().Truncate(time.Microsecond)
+}
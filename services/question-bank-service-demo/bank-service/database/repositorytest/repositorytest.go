@@ -0,0 +1,363 @@
+// Package repositorytest is the conformance suite every question bank store
+// must pass. A backend's test calls Run with a function returning an empty
+// store:
+//
+//	func TestMemoryStore(t *testing.T) {
+//		repositorytest.Run(t, func(t *testing.T) database.Store {
+//			return database.NewMemoryStore()
+//		})
+//	}
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database"
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/models"
+)
+
+// Run runs every check against a fresh store from newStore
+func Run(t *testing.T, newStore func(t *testing.T) database.Store) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, ctx context.Context, store database.Store)
+	}{
+		{"SaveAndGetQuestion", testSaveAndGetQuestion},
+		{"SaveQuestionFillsDefaults", testSaveQuestionFillsDefaults},
+		{"SaveQuestionReplaces", testSaveQuestionReplaces},
+		{"GetMissingQuestion", testGetMissingQuestion},
+		{"DeleteQuestion", testDeleteQuestion},
+		{"GetQuestionsByQuizID", testGetQuestionsByQuizID},
+		{"GetQuestionsByQuizIDPages", testGetQuestionsByQuizIDPages},
+		{"GetQuestionsByQuizIDInvalidToken", testGetQuestionsByQuizIDInvalidToken},
+		{"SaveAndGetOptions", testSaveAndGetOptions},
+		{"SaveOptionFillsID", testSaveOptionFillsID},
+		{"GetOptionsByQuestionIDs", testGetOptionsByQuestionIDs},
+		{"DeleteOptionsByQuestionID", testDeleteOptionsByQuestionID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			tt.test(t, ctx, newStore(t))
+		})
+	}
+}
+
+func testSaveAndGetQuestion(t *testing.T, ctx context.Context, store database.Store) {
+	question := models.NewQuestion("quiz-1", "What is the SI unit of force?", models.QuestionTypeShortAnswer, "Newton")
+	mustSaveQuestion(t, ctx, store, &question)
+
+	got, err := store.GetQuestionByID(ctx, question.QuestionID)
+	if err != nil {
+		t.Fatalf("GetQuestionByID: %v", err)
+	}
+	assertQuestion(t, *got, question)
+}
+
+func testSaveQuestionFillsDefaults(t *testing.T, ctx context.Context, store database.Store) {
+	question := models.Question{QuizID: "quiz-1", QuestionText: "Water boils at 100 °C at sea level", QuestionType: models.QuestionTypeTrueFalse}
+	before := time.Now().Add(-time.Second)
+	mustSaveQuestion(t, ctx, store, &question)
+
+	if question.QuestionID == "" {
+		t.Fatal("SaveQuestion did not assign a QuestionID")
+	}
+	if question.CreatedAt.Before(before) || question.UpdatedAt.Before(before) {
+		t.Fatalf("SaveQuestion did not set the timestamps: created %v, updated %v", question.CreatedAt, question.UpdatedAt)
+	}
+	got, err := store.GetQuestionByID(ctx, question.QuestionID)
+	if err != nil {
+		t.Fatalf("GetQuestionByID: %v", err)
+	}
+	assertQuestion(t, *got, question)
+}
+
+func testSaveQuestionReplaces(t *testing.T, ctx context.Context, store database.Store) {
+	question := models.NewQuestion("quiz-1", "Old text", models.QuestionTypeFillBlank, "old")
+	mustSaveQuestion(t, ctx, store, &question)
+	createdAt := question.CreatedAt
+
+	question.QuestionText = "New text"
+	question.Answer = "new"
+	mustSaveQuestion(t, ctx, store, &question)
+
+	got, err := store.GetQuestionByID(ctx, question.QuestionID)
+	if err != nil {
+		t.Fatalf("GetQuestionByID: %v", err)
+	}
+	assertQuestion(t, *got, question)
+	if !got.CreatedAt.Equal(createdAt) {
+		t.Errorf("CreatedAt changed on update: %v, was %v", got.CreatedAt, createdAt)
+	}
+}
+
+func testGetMissingQuestion(t *testing.T, ctx context.Context, store database.Store) {
+	_, err := store.GetQuestionByID(ctx, "no-such-question")
+	if !errors.Is(err, database.ErrQuestionNotFound) {
+		t.Fatalf("GetQuestionByID of a missing question = %v, want ErrQuestionNotFound", err)
+	}
+}
+
+func testDeleteQuestion(t *testing.T, ctx context.Context, store database.Store) {
+	question := models.NewQuestion("quiz-1", "Delete me", models.QuestionTypeShortAnswer, "ok")
+	mustSaveQuestion(t, ctx, store, &question)
+
+	if err := store.DeleteQuestion(ctx, question.QuestionID); err != nil {
+		t.Fatalf("DeleteQuestion: %v", err)
+	}
+	if _, err := store.GetQuestionByID(ctx, question.QuestionID); !errors.Is(err, database.ErrQuestionNotFound) {
+		t.Fatalf("GetQuestionByID after delete = %v, want ErrQuestionNotFound", err)
+	}
+	if err := store.DeleteQuestion(ctx, question.QuestionID); err != nil {
+		t.Fatalf("DeleteQuestion of a missing question: %v", err)
+	}
+}
+
+func testGetQuestionsByQuizID(t *testing.T, ctx context.Context, store database.Store) {
+	var want []models.Question
+	for _, text := range []string{"First", "Second", "Third"} {
+		question := models.NewQuestion("quiz-1", text, models.QuestionTypeShortAnswer, "answer")
+		mustSaveQuestion(t, ctx, store, &question)
+		want = append(want, question)
+	}
+	other := models.NewQuestion("quiz-2", "Other quiz", models.QuestionTypeShortAnswer, "answer")
+	mustSaveQuestion(t, ctx, store, &other)
+
+	page, err := store.GetQuestionsByQuizID(ctx, "quiz-1", database.PageRequest{})
+	if err != nil {
+		t.Fatalf("GetQuestionsByQuizID: %v", err)
+	}
+	if page.NextPageToken != "" {
+		t.Errorf("GetQuestionsByQuizID returned NextPageToken %q for a single page", page.NextPageToken)
+	}
+	got := page.Questions
+	if len(got) != len(want) {
+		t.Fatalf("GetQuestionsByQuizID returned %d questions, want %d", len(got), len(want))
+	}
+	sortQuestions(got)
+	sortQuestions(want)
+	for i := range want {
+		assertQuestion(t, got[i], want[i])
+	}
+
+	empty, err := store.GetQuestionsByQuizID(ctx, "no-such-quiz", database.PageRequest{})
+	if err != nil {
+		t.Fatalf("GetQuestionsByQuizID of an empty quiz: %v", err)
+	}
+	if empty.Questions == nil || len(empty.Questions) != 0 || empty.NextPageToken != "" {
+		t.Fatalf("GetQuestionsByQuizID of an empty quiz = %#v, want an empty slice", empty)
+	}
+}
+
+func testGetQuestionsByQuizIDPages(t *testing.T, ctx context.Context, store database.Store) {
+	want := map[string]models.Question{}
+	for i := 0; i < 7; i++ {
+		question := models.NewQuestion("quiz-1", fmt.Sprintf("Question %d", i), models.QuestionTypeShortAnswer, "answer")
+		mustSaveQuestion(t, ctx, store, &question)
+		want[question.QuestionID] = question
+	}
+	other := models.NewQuestion("quiz-2", "Other quiz", models.QuestionTypeShortAnswer, "answer")
+	mustSaveQuestion(t, ctx, store, &other)
+
+	var sizes []int
+	seen := map[string]bool{}
+	page := database.PageRequest{Size: 3}
+	for {
+		got, err := store.GetQuestionsByQuizID(ctx, "quiz-1", page)
+		if err != nil {
+			t.Fatalf("GetQuestionsByQuizID page %d: %v", len(sizes)+1, err)
+		}
+		sizes = append(sizes, len(got.Questions))
+		for _, question := range got.Questions {
+			if seen[question.QuestionID] {
+				t.Fatalf("question %s returned twice", question.QuestionID)
+			}
+			seen[question.QuestionID] = true
+			w, ok := want[question.QuestionID]
+			if !ok {
+				t.Fatalf("GetQuestionsByQuizID returned question %s of another quiz", question.QuestionID)
+			}
+			assertQuestion(t, question, w)
+		}
+		if got.NextPageToken == "" {
+			break
+		}
+		if len(sizes) > len(want) {
+			t.Fatal("GetQuestionsByQuizID keeps returning pages")
+		}
+		page.Token = got.NextPageToken
+	}
+
+	if len(seen) != len(want) {
+		t.Fatalf("paging returned %d questions, want %d", len(seen), len(want))
+	}
+	if fmt.Sprint(sizes) != "[3 3 1]" {
+		t.Errorf("page sizes = %v, want [3 3 1]", sizes)
+	}
+}
+
+func testGetQuestionsByQuizIDInvalidToken(t *testing.T, ctx context.Context, store database.Store) {
+	for _, token := range []string{"not a token", "e30"} {
+		_, err := store.GetQuestionsByQuizID(ctx, "quiz-1", database.PageRequest{Token: token})
+		if !errors.Is(err, database.ErrInvalidPageToken) {
+			t.Errorf("GetQuestionsByQuizID with token %q = %v, want ErrInvalidPageToken", token, err)
+		}
+	}
+}
+
+func testSaveAndGetOptions(t *testing.T, ctx context.Context, store database.Store) {
+	want := []models.Option{
+		models.NewOption("question-1", "Newton", true),
+		models.NewOption("question-1", "Joule", false),
+		models.NewOption("question-1", "Watt", false),
+	}
+	for i := range want {
+		mustSaveOption(t, ctx, store, &want[i])
+	}
+	other := models.NewOption("question-2", "Pascal", true)
+	mustSaveOption(t, ctx, store, &other)
+
+	got, err := store.GetOptionsByQuestionID(ctx, "question-1")
+	if err != nil {
+		t.Fatalf("GetOptionsByQuestionID: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("GetOptionsByQuestionID returned %d options, want %d", len(got), len(want))
+	}
+	sortOptions(got)
+	sortOptions(want)
+	for i := range want {
+		assertOption(t, got[i], want[i])
+	}
+
+	empty, err := store.GetOptionsByQuestionID(ctx, "no-such-question")
+	if err != nil {
+		t.Fatalf("GetOptionsByQuestionID of a question without options: %v", err)
+	}
+	if empty == nil || len(empty) != 0 {
+		t.Fatalf("GetOptionsByQuestionID of a question without options = %#v, want an empty slice", empty)
+	}
+}
+
+func testSaveOptionFillsID(t *testing.T, ctx context.Context, store database.Store) {
+	option := models.Option{QuestionID: "question-1", OptionText: "True", IsCorrect: true}
+	mustSaveOption(t, ctx, store, &option)
+	if option.OptionID == "" {
+		t.Fatal("SaveOption did not assign an OptionID")
+	}
+
+	got, err := store.GetOptionsByQuestionID(ctx, "question-1")
+	if err != nil {
+		t.Fatalf("GetOptionsByQuestionID: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("GetOptionsByQuestionID returned %d options, want 1", len(got))
+	}
+	assertOption(t, got[0], option)
+}
+
+func testGetOptionsByQuestionIDs(t *testing.T, ctx context.Context, store database.Store) {
+	want := map[string][]models.Option{}
+	for _, questionID := range []string{"question-1", "question-2", "question-3"} {
+		for _, text := range []string{"A", "B"} {
+			option := models.NewOption(questionID, text, text == "A")
+			mustSaveOption(t, ctx, store, &option)
+			want[questionID] = append(want[questionID], option)
+		}
+	}
+
+	got, err := store.GetOptionsByQuestionIDs(ctx, []string{"question-1", "question-3", "no-such-question"})
+	if err != nil {
+		t.Fatalf("GetOptionsByQuestionIDs: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("GetOptionsByQuestionIDs returned options of %d questions, want 2", len(got))
+	}
+	for _, questionID := range []string{"question-1", "question-3"} {
+		options := got[questionID]
+		if len(options) != len(want[questionID]) {
+			t.Fatalf("GetOptionsByQuestionIDs returned %d options of %s, want %d", len(options), questionID, len(want[questionID]))
+		}
+		sortOptions(options)
+		sortOptions(want[questionID])
+		for i := range options {
+			assertOption(t, options[i], want[questionID][i])
+		}
+	}
+
+	none, err := store.GetOptionsByQuestionIDs(ctx, nil)
+	if err != nil || len(none) != 0 {
+		t.Fatalf("GetOptionsByQuestionIDs of no questions = %v, %v; want nothing", none, err)
+	}
+}
+
+func testDeleteOptionsByQuestionID(t *testing.T, ctx context.Context, store database.Store) {
+	for _, text := range []string{"A", "B"} {
+		option := models.NewOption("question-1", text, text == "A")
+		mustSaveOption(t, ctx, store, &option)
+	}
+	kept := models.NewOption("question-2", "C", true)
+	mustSaveOption(t, ctx, store, &kept)
+
+	if err := store.DeleteOptionsByQuestionID(ctx, "question-1"); err != nil {
+		t.Fatalf("DeleteOptionsByQuestionID: %v", err)
+	}
+	if got, err := store.GetOptionsByQuestionID(ctx, "question-1"); err != nil || len(got) != 0 {
+		t.Fatalf("GetOptionsByQuestionID after delete = %d options, %v; want none", len(got), err)
+	}
+	if got, err := store.GetOptionsByQuestionID(ctx, "question-2"); err != nil || len(got) != 1 {
+		t.Fatalf("options of another question were deleted: %d left, %v", len(got), err)
+	}
+	if err := store.DeleteOptionsByQuestionID(ctx, "no-such-question"); err != nil {
+		t.Fatalf("DeleteOptionsByQuestionID of a question without options: %v", err)
+	}
+}
+
+func mustSaveQuestion(t *testing.T, ctx context.Context, store database.Store, question *models.Question) {
+	t.Helper()
+	if err := store.SaveQuestion(ctx, question); err != nil {
+		t.Fatalf("SaveQuestion: %v", err)
+	}
+}
+
+func mustSaveOption(t *testing.T, ctx context.Context, store database.Store, option *models.Option) {
+	t.Helper()
+	if err := store.SaveOption(ctx, option); err != nil {
+		t.Fatalf("SaveOption: %v", err)
+	}
+}
+
+func assertQuestion(t *testing.T, got, want models.Question) {
+	t.Helper()
+	if got.QuestionID != want.QuestionID || got.QuizID != want.QuizID || got.QuestionText != want.QuestionText ||
+		got.QuestionType != want.QuestionType || got.Answer != want.Answer {
+		t.Errorf("got question %+v, want %+v", got, want)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || !got.UpdatedAt.Equal(want.UpdatedAt) {
+		t.Errorf("got timestamps %v/%v, want %v/%v", got.CreatedAt, got.UpdatedAt, want.CreatedAt, want.UpdatedAt)
+	}
+}
+
+func assertOption(t *testing.T, got, want models.Option) {
+	t.Helper()
+	if got.OptionID != want.OptionID || got.QuestionID != want.QuestionID || got.OptionText != want.OptionText || got.IsCorrect != want.IsCorrect {
+		t.Errorf("got option %+v, want %+v", got, want)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || !got.UpdatedAt.Equal(want.UpdatedAt) {
+		t.Errorf("got timestamps %v/%v, want %v/%v", got.CreatedAt, got.UpdatedAt, want.CreatedAt, want.UpdatedAt)
+	}
+}
+
+func sortQuestions(questions []models.Question) {
+	sort.Slice(questions, func(i, j int) bool { return questions[i].QuestionID < questions[j].QuestionID })
+}
+
+func sortOptions(options []models.Option) {
+	sort.Slice(options, func(i, j int) bool { return options[i].OptionID < options[j].OptionID })
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import "github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database"
+
+// Handler serves the question bank routes. It only knows the repository
+// interfaces, so tests can hand it a database.MemoryStore.
+type Handler struct {
+	Questions database.QuestionRepository
+	Options   database.OptionRepository
+}
+
+// NewHandler serves questions and options from the same store
+func NewHandler(store database.Store) *Handler {
+	return &Handler{Questions: store, Options: store}
+}
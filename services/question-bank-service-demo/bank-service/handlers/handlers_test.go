@@ -0,0 +1,256 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database"
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/handlers"
+	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/models"
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type route func(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+
+func newHandler() (*handlers.Handler, *database.MemoryStore) {
+	store := database.NewMemoryStore()
+	return handlers.NewHandler(store), store
+}
+
+func TestAddQuestionValidation(t *testing.T) {
+	h, _ := newHandler()
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"BadJSON", `{`, "Invalid request format"},
+		{"NoQuizID", `{"question_text": "Q", "question_type": "Short Answer", "answer": "a"}`, "quiz_id is required"},
+		{"NoText", `{"quiz_id": "quiz-1", "question_type": "Short Answer", "answer": "a"}`, "question_text is required"},
+		{"UnknownType", `{"quiz_id": "quiz-1", "question_text": "Q", "question_type": "Essay"}`, "Invalid question_type"},
+		{"MCQOneOption", `{"quiz_id": "quiz-1", "question_text": "Q", "question_type": "MCQ", "options": [{"option_text": "A", "is_correct": true}]}`, "at least 2 options"},
+		{"MCQNoCorrectOption", `{"quiz_id": "quiz-1", "question_text": "Q", "question_type": "MCQ", "options": [{"option_text": "A"}, {"option_text": "B"}]}`, "must be marked as correct"},
+		{"TrueFalseThreeOptions", `{"quiz_id": "quiz-1", "question_text": "Q", "question_type": "True/False", "options": [{"option_text": "A", "is_correct": true}, {"option_text": "B"}, {"option_text": "C"}]}`, "exactly 2 options"},
+		{"TrueFalseTwoCorrect", `{"quiz_id": "quiz-1", "question_text": "Q", "question_type": "True/False", "options": [{"option_text": "A", "is_correct": true}, {"option_text": "B", "is_correct": true}]}`, "exactly one correct option"},
+		{"ShortAnswerNoAnswer", `{"quiz_id": "quiz-1", "question_text": "Q", "question_type": "Short Answer"}`, "Answer is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := call(t, h.AddQuestion, events.APIGatewayProxyRequest{Body: tt.body})
+			assertError(t, resp, http.StatusBadRequest, tt.want)
+		})
+	}
+}
+
+func TestAddAndGetQuestion(t *testing.T) {
+	h, _ := newHandler()
+	created := addQuestion(t, h, "quiz-1", "What is the SI unit of force?")
+	if len(created.Options) != 2 {
+		t.Fatalf("AddQuestion returned %d options, want 2", len(created.Options))
+	}
+
+	resp := call(t, h.GetQuestion, pathRequest("questionId", created.Question.QuestionID))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GetQuestion = %d %s, want 200", resp.StatusCode, resp.Body)
+	}
+	var got models.QuestionWithOptions
+	decode(t, resp, &got)
+	if got.Question.QuestionID != created.Question.QuestionID || got.Question.QuestionText != "What is the SI unit of force?" {
+		t.Errorf("GetQuestion returned %+v, want %+v", got.Question, created.Question)
+	}
+	if len(got.Options) != 2 {
+		t.Errorf("GetQuestion returned %d options, want 2", len(got.Options))
+	}
+}
+
+func TestGetQuestionErrors(t *testing.T) {
+	h, _ := newHandler()
+	assertError(t, call(t, h.GetQuestion, events.APIGatewayProxyRequest{}), http.StatusBadRequest, "Question ID is required")
+	assertError(t, call(t, h.GetQuestion, pathRequest("questionId", "no-such-question")), http.StatusNotFound, "Question not found")
+}
+
+func TestUpdateQuestion(t *testing.T) {
+	h, store := newHandler()
+	created := addQuestion(t, h, "quiz-1", "Old text")
+	id := created.Question.QuestionID
+
+	request := pathRequest("questionId", id)
+	request.Body = `{"question_text": "New text", "options": [{"option_text": "Newton", "is_correct": true}, {"option_text": "Joule"}, {"option_text": "Watt"}]}`
+	resp := call(t, h.UpdateQuestion, request)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("UpdateQuestion = %d %s, want 200", resp.StatusCode, resp.Body)
+	}
+	var got models.QuestionWithOptions
+	decode(t, resp, &got)
+	if got.Question.QuestionText != "New text" {
+		t.Errorf("UpdateQuestion left the text %q", got.Question.QuestionText)
+	}
+	options, err := store.GetOptionsByQuestionID(context.Background(), id)
+	if err != nil || len(options) != 3 {
+		t.Fatalf("options after UpdateQuestion = %d, %v; want the 3 new ones", len(options), err)
+	}
+
+	request.Body = `{"question_type": "Essay"}`
+	assertError(t, call(t, h.UpdateQuestion, request), http.StatusBadRequest, "Invalid question_type")
+	request.Body = `{`
+	assertError(t, call(t, h.UpdateQuestion, request), http.StatusBadRequest, "Invalid request format")
+
+	missing := pathRequest("questionId", "no-such-question")
+	missing.Body = `{"question_text": "New text"}`
+	assertError(t, call(t, h.UpdateQuestion, missing), http.StatusNotFound, "Question not found")
+	assertError(t, call(t, h.UpdateQuestion, events.APIGatewayProxyRequest{Body: `{}`}), http.StatusBadRequest, "Question ID is required")
+}
+
+func TestDeleteQuestion(t *testing.T) {
+	h, store := newHandler()
+	created := addQuestion(t, h, "quiz-1", "Delete me")
+	id := created.Question.QuestionID
+
+	resp := call(t, h.DeleteQuestion, pathRequest("questionId", id))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("DeleteQuestion = %d %s, want 200", resp.StatusCode, resp.Body)
+	}
+	if options, err := store.GetOptionsByQuestionID(context.Background(), id); err != nil || len(options) != 0 {
+		t.Fatalf("options after DeleteQuestion = %d, %v; want none", len(options), err)
+	}
+	assertError(t, call(t, h.GetQuestion, pathRequest("questionId", id)), http.StatusNotFound, "Question not found")
+	assertError(t, call(t, h.DeleteQuestion, pathRequest("questionId", id)), http.StatusNotFound, "Question not found")
+	assertError(t, call(t, h.DeleteQuestion, events.APIGatewayProxyRequest{}), http.StatusBadRequest, "Question ID is required")
+}
+
+func TestAddOption(t *testing.T) {
+	h, _ := newHandler()
+	created := addQuestion(t, h, "quiz-1", "What is the SI unit of power?")
+	id := created.Question.QuestionID
+
+	body := fmt.Sprintf(`{"question_id": %q, "option_text": "Watt", "is_correct": true}`, id)
+	resp := call(t, h.AddOption, events.APIGatewayProxyRequest{Body: body})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("AddOption = %d %s, want 201", resp.StatusCode, resp.Body)
+	}
+
+	resp = call(t, h.GetOptionsByQuestion, pathRequest("questionId", id))
+	var options []models.Option
+	decode(t, resp, &options)
+	if len(options) != 3 {
+		t.Errorf("GetOptionsByQuestion returned %d options, want 3", len(options))
+	}
+
+	assertError(t, call(t, h.AddOption, events.APIGatewayProxyRequest{Body: `{"question_id": "x"}`}), http.StatusBadRequest, "question_id and option_text are required")
+	assertError(t, call(t, h.AddOption, events.APIGatewayProxyRequest{Body: `{"question_id": "no-such-question", "option_text": "A"}`}), http.StatusNotFound, "Question not found")
+	assertError(t, call(t, h.GetOptionsByQuestion, events.APIGatewayProxyRequest{}), http.StatusBadRequest, "Question ID is required")
+}
+
+func TestGetQuestionsByQuiz(t *testing.T) {
+	h, _ := newHandler()
+	want := map[string]bool{}
+	for i := 0; i < 5; i++ {
+		created := addQuestion(t, h, "quiz-1", fmt.Sprintf("Question %d", i))
+		want[created.Question.QuestionID] = true
+	}
+	addQuestion(t, h, "quiz-2", "Other quiz")
+
+	seen := map[string]bool{}
+	var sizes []int
+	token := ""
+	for {
+		request := pathRequest("quizId", "quiz-1")
+		request.QueryStringParameters = map[string]string{"page_size": "2", "page_token": token}
+		resp := call(t, h.GetQuestionsByQuiz, request)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GetQuestionsByQuiz = %d %s, want 200", resp.StatusCode, resp.Body)
+		}
+		var page handlers.QuestionsPage
+		decode(t, resp, &page)
+		sizes = append(sizes, len(page.Questions))
+		for _, question := range page.Questions {
+			if !want[question.Question.QuestionID] || seen[question.Question.QuestionID] {
+				t.Fatalf("unexpected or repeated question %s", question.Question.QuestionID)
+			}
+			seen[question.Question.QuestionID] = true
+			if len(question.Options) != 2 {
+				t.Errorf("question %s came with %d options, want 2", question.Question.QuestionID, len(question.Options))
+			}
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		if len(sizes) > len(want) {
+			t.Fatal("GetQuestionsByQuiz keeps returning pages")
+		}
+		token = page.NextPageToken
+	}
+	if len(seen) != len(want) || fmt.Sprint(sizes) != "[2 2 1]" {
+		t.Errorf("paging returned %d questions in pages %v, want %d in [2 2 1]", len(seen), sizes, len(want))
+	}
+
+	resp := call(t, h.GetQuestionsByQuiz, pathRequest("quizId", "no-such-quiz"))
+	if resp.StatusCode != http.StatusOK || !strings.Contains(resp.Body, `"questions":[]`) {
+		t.Errorf("GetQuestionsByQuiz of an empty quiz = %d %s, want an empty list", resp.StatusCode, resp.Body)
+	}
+}
+
+func TestGetQuestionsByQuizErrors(t *testing.T) {
+	h, _ := newHandler()
+	assertError(t, call(t, h.GetQuestionsByQuiz, events.APIGatewayProxyRequest{}), http.StatusBadRequest, "Quiz ID is required")
+
+	for _, size := range []string{"0", "-1", "101", "ten"} {
+		request := pathRequest("quizId", "quiz-1")
+		request.QueryStringParameters = map[string]string{"page_size": size}
+		assertError(t, call(t, h.GetQuestionsByQuiz, request), http.StatusBadRequest, "page_size must be between 1 and 100")
+	}
+
+	request := pathRequest("quizId", "quiz-1")
+	request.QueryStringParameters = map[string]string{"page_token": "not a token"}
+	assertError(t, call(t, h.GetQuestionsByQuiz, request), http.StatusBadRequest, "Invalid page_token")
+}
+
+// addQuestion adds an MCQ with two options through the handler
+func addQuestion(t *testing.T, h *handlers.Handler, quizID, text string) models.QuestionWithOptions {
+	t.Helper()
+	body := fmt.Sprintf(`{"quiz_id": %q, "question_text": %q, "question_type": "MCQ", "options": [{"option_text": "Newton", "is_correct": true}, {"option_text": "Joule"}]}`, quizID, text)
+	resp := call(t, h.AddQuestion, events.APIGatewayProxyRequest{Body: body})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("AddQuestion = %d %s, want 201", resp.StatusCode, resp.Body)
+	}
+	var created models.QuestionWithOptions
+	decode(t, resp, &created)
+	return created
+}
+
+func pathRequest(name, value string) events.APIGatewayProxyRequest {
+	return events.APIGatewayProxyRequest{PathParameters: map[string]string{name: value}}
+}
+
+func call(t *testing.T, handler route, request events.APIGatewayProxyRequest) events.APIGatewayProxyResponse {
+	t.Helper()
+	resp, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("handler returned an error: %v", err)
+	}
+	return resp
+}
+
+func decode(t *testing.T, resp events.APIGatewayProxyResponse, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal([]byte(resp.Body), v); err != nil {
+		t.Fatalf("invalid response body %s: %v", resp.Body, err)
+	}
+}
+
+func assertError(t *testing.T, resp events.APIGatewayProxyResponse, status int, message string) {
+	t.Helper()
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(resp.Body), &body); err != nil {
+		t.Fatalf("invalid error body %s: %v", resp.Body, err)
+	}
+	if resp.StatusCode != status || !strings.Contains(body.Error, message) {
+		t.Errorf("got %d %q, want %d with %q", resp.StatusCode, body.Error, status, message)
+	}
+}
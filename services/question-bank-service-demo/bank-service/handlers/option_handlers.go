@@ -1,12 +1,12 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 
-	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database"
 	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/models"
 	"github.com/aws/aws-lambda-go/events"
 )
@@ -19,7 +19,7 @@ type AddOptionRequest struct {
 }
 
 // AddOption handles adding a new option
-func AddOption(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+func (h *Handler) AddOption(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	log.Println("Processing AddOption request")
 
 	// Parse request body
@@ -42,7 +42,7 @@ func AddOption(request events.APIGatewayProxyRequest) (events.APIGatewayProxyRes
 	}
 
 	// Ensure the question exists
-	_, err = database.GetQuestionByID(req.QuestionID)
+	_, err = h.Questions.GetQuestionByID(ctx, req.QuestionID)
 	if err != nil {
 		log.Printf("Error fetching question: %v", err)
 		return events.APIGatewayProxyResponse{
@@ -53,7 +53,7 @@ func AddOption(request events.APIGatewayProxyRequest) (events.APIGatewayProxyRes
 
 	// Create and save the option
 	option := models.NewOption(req.QuestionID, req.OptionText, req.IsCorrect)
-	err = database.SaveOption(option)
+	err = h.Options.SaveOption(ctx, &option)
 	if err != nil {
 		log.Printf("Error saving option: %v", err)
 		return events.APIGatewayProxyResponse{
@@ -98,7 +98,7 @@ func AddOption(request events.APIGatewayProxyRequest) (events.APIGatewayProxyRes
 // }
 
 // GetOptionsByQuestion handles fetching all options for a question
-func GetOptionsByQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+func (h *Handler) GetOptionsByQuestion(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	log.Println("Processing GetOptionsByQuestion request")
 
 	questionID := request.PathParameters["questionId"]
@@ -109,7 +109,7 @@ func GetOptionsByQuestion(request events.APIGatewayProxyRequest) (events.APIGate
 		}, nil
 	}
 
-	options, err := database.GetOptionsByQuestionID(questionID)
+	options, err := h.Options.GetOptionsByQuestionID(ctx, questionID)
 	if err != nil {
 		log.Printf("Error fetching options: %v", err)
 		return events.APIGatewayProxyResponse{
@@ -1,11 +1,13 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
-	"strings"
+	"strconv"
 	"time"
 
 	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/database"
@@ -29,7 +31,7 @@ type OptionInput struct {
 }
 
 // AddQuestion handles adding a new question with options
-func AddQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+func (h *Handler) AddQuestion(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	log.Println("Processing AddQuestion request")
 
 	// Parse request body
@@ -155,7 +157,7 @@ func AddQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyR
 		question.Answer = req.Answer
 	}
 
-	err = database.SaveQuestion(question)
+	err = h.Questions.SaveQuestion(ctx, &question)
 	if err != nil {
 		log.Printf("Error saving question: %v", err)
 		return events.APIGatewayProxyResponse{
@@ -171,7 +173,7 @@ func AddQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyR
 	if req.QuestionType == models.QuestionTypeMCQ || req.QuestionType == models.QuestionTypeTrueFalse {
 		for _, optInput := range req.Options {
 			option := models.NewOption(question.QuestionID, optInput.OptionText, optInput.IsCorrect)
-			err = database.SaveOption(option)
+			err = h.Options.SaveOption(ctx, &option)
 			if err != nil {
 				log.Printf("Error saving option: %v", err)
 				// Continue saving other options
@@ -180,7 +182,7 @@ func AddQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyR
 	}
 
 	// Fetch the newly created question with options
-	result, err := getQuestionWithOptions(question.QuestionID)
+	result, err := h.getQuestionWithOptions(ctx, question.QuestionID)
 	if err != nil {
 		log.Printf("Error fetching created question: %v", err)
 		// Return just the question ID if there's an error
@@ -205,7 +207,7 @@ func AddQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyR
 }
 
 // GetQuestion handles fetching a question by ID with its options
-func GetQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+func (h *Handler) GetQuestion(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	log.Println("Processing GetQuestion request")
 
 	// Get question ID from path parameters
@@ -221,10 +223,10 @@ func GetQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyR
 	}
 
 	// Fetch the question with options
-	result, err := getQuestionWithOptions(questionID)
+	result, err := h.getQuestionWithOptions(ctx, questionID)
 	if err != nil {
 		log.Printf("Error fetching question: %v", err)
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, database.ErrQuestionNotFound) {
 			return events.APIGatewayProxyResponse{
 				StatusCode: http.StatusNotFound,
 				Body:       `{"error": "Question not found"}`,
@@ -254,7 +256,7 @@ func GetQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyR
 }
 
 // UpdateQuestion handles updating a question with its options
-func UpdateQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+func (h *Handler) UpdateQuestion(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	log.Println("Processing UpdateQuestion request")
 
 	// Get question ID from path parameters
@@ -284,10 +286,10 @@ func UpdateQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayPro
 	}
 
 	// Fetch the existing question
-	question, err := database.GetQuestionByID(questionID)
+	question, err := h.Questions.GetQuestionByID(ctx, questionID)
 	if err != nil {
 		log.Printf("Error fetching question: %v", err)
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, database.ErrQuestionNotFound) {
 			return events.APIGatewayProxyResponse{
 				StatusCode: http.StatusNotFound,
 				Body:       `{"error": "Question not found"}`,
@@ -407,7 +409,7 @@ func UpdateQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayPro
 		question.UpdatedAt = time.Now()
 
 		// Save the updated question
-		err = database.SaveQuestion(*question)
+		err = h.Questions.SaveQuestion(ctx, question)
 		if err != nil {
 			log.Printf("Error saving question: %v", err)
 			return events.APIGatewayProxyResponse{
@@ -423,7 +425,7 @@ func UpdateQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayPro
 	// Handle options update - only if MCQ or True/False
 	if (question.QuestionType == models.QuestionTypeMCQ || question.QuestionType == models.QuestionTypeTrueFalse) && len(req.Options) > 0 {
 		// Delete existing options
-		err = database.DeleteOptionsByQuestionID(questionID)
+		err = h.Options.DeleteOptionsByQuestionID(ctx, questionID)
 		if err != nil {
 			log.Printf("Error deleting existing options: %v", err)
 			// Continue anyway as this might be a transient error
@@ -432,7 +434,7 @@ func UpdateQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayPro
 		// Save new options
 		for _, optInput := range req.Options {
 			option := models.NewOption(questionID, optInput.OptionText, optInput.IsCorrect)
-			err = database.SaveOption(option)
+			err = h.Options.SaveOption(ctx, &option)
 			if err != nil {
 				log.Printf("Error saving option: %v", err)
 				// Continue saving other options
@@ -441,7 +443,7 @@ func UpdateQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayPro
 	}
 
 	// Fetch the updated question with options
-	result, err := getQuestionWithOptions(questionID)
+	result, err := h.getQuestionWithOptions(ctx, questionID)
 	if err != nil {
 		log.Printf("Error fetching updated question: %v", err)
 		return events.APIGatewayProxyResponse{
@@ -465,7 +467,7 @@ func UpdateQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayPro
 }
 
 // DeleteQuestion handles deleting a question and its options
-func DeleteQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+func (h *Handler) DeleteQuestion(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	log.Println("Processing DeleteQuestion request")
 
 	// Get question ID from path parameters
@@ -481,10 +483,10 @@ func DeleteQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayPro
 	}
 
 	// Check if the question exists
-	_, err := database.GetQuestionByID(questionID)
+	_, err := h.Questions.GetQuestionByID(ctx, questionID)
 	if err != nil {
 		log.Printf("Error fetching question: %v", err)
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, database.ErrQuestionNotFound) {
 			return events.APIGatewayProxyResponse{
 				StatusCode: http.StatusNotFound,
 				Body:       `{"error": "Question not found"}`,
@@ -503,14 +505,14 @@ func DeleteQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayPro
 	}
 
 	// Delete associated options first
-	err = database.DeleteOptionsByQuestionID(questionID)
+	err = h.Options.DeleteOptionsByQuestionID(ctx, questionID)
 	if err != nil {
 		log.Printf("Error deleting options: %v", err)
 		// Continue with question deletion anyway
 	}
 
 	// Delete the question
-	err = database.DeleteQuestion(questionID)
+	err = h.Questions.DeleteQuestion(ctx, questionID)
 	if err != nil {
 		log.Printf("Error deleting question: %v", err)
 		return events.APIGatewayProxyResponse{
@@ -532,8 +534,17 @@ func DeleteQuestion(request events.APIGatewayProxyRequest) (events.APIGatewayPro
 	}, nil
 }
 
-// GetQuestionsByQuiz handles fetching all questions for a quiz
-func GetQuestionsByQuiz(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+// QuestionsPage is one page of a quiz's questions. Pass NextPageToken as
+// page_token to get the next one; it's left out on the last page.
+type QuestionsPage struct {
+	Questions     []models.QuestionWithOptions `json:"questions"`
+	NextPageToken string                       `json:"next_page_token,omitempty"`
+}
+
+// GetQuestionsByQuiz handles fetching a page of a quiz's questions, with
+// their options. page_size (up to 100, default 50) and page_token come from
+// the query string.
+func (h *Handler) GetQuestionsByQuiz(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	log.Println("Processing GetQuestionsByQuiz request")
 
 	// Get quiz ID from path parameters
@@ -548,8 +559,31 @@ func GetQuestionsByQuiz(request events.APIGatewayProxyRequest) (events.APIGatewa
 		}, nil
 	}
 
-	// Fetch all questions for the quiz
-	questions, err := database.GetQuestionsByQuizID(quizID)
+	page := database.PageRequest{Token: request.QueryStringParameters["page_token"]}
+	if size := request.QueryStringParameters["page_size"]; size != "" {
+		page.Size, _ = strconv.Atoi(size)
+		if page.Size <= 0 || page.Size > database.MaxPageSize {
+			return events.APIGatewayProxyResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       fmt.Sprintf(`{"error": "page_size must be between 1 and %d"}`, database.MaxPageSize),
+				Headers: map[string]string{
+					"Content-Type": "application/json",
+				},
+			}, nil
+		}
+	}
+
+	// Fetch one page of the quiz's questions
+	questions, err := h.Questions.GetQuestionsByQuizID(ctx, quizID, page)
+	if errors.Is(err, database.ErrInvalidPageToken) {
+		return events.APIGatewayProxyResponse{
+			StatusCode: http.StatusBadRequest,
+			Body:       `{"error": "Invalid page_token"}`,
+			Headers: map[string]string{
+				"Content-Type": "application/json",
+			},
+		}, nil
+	}
 	if err != nil {
 		log.Printf("Error fetching questions: %v", err)
 		return events.APIGatewayProxyResponse{
@@ -561,24 +595,36 @@ func GetQuestionsByQuiz(request events.APIGatewayProxyRequest) (events.APIGatewa
 		}, nil
 	}
 
-	// For each question, fetch its options
-	var questionsWithOptions []models.QuestionWithOptions
-	for _, q := range questions {
-		options, err := database.GetOptionsByQuestionID(q.QuestionID)
-		if err != nil {
-			log.Printf("Error fetching options for question %s: %v", q.QuestionID, err)
-			// Continue with next question
-			continue
-		}
+	// Fetch the options of the whole page at once
+	questionIDs := make([]string, len(questions.Questions))
+	for i, q := range questions.Questions {
+		questionIDs[i] = q.QuestionID
+	}
+	options, err := h.Options.GetOptionsByQuestionIDs(ctx, questionIDs)
+	if err != nil {
+		log.Printf("Error fetching options: %v", err)
+		return events.APIGatewayProxyResponse{
+			StatusCode: http.StatusInternalServerError,
+			Body:       fmt.Sprintf(`{"error": "Failed to fetch options: %s"}`, err.Error()),
+			Headers: map[string]string{
+				"Content-Type": "application/json",
+			},
+		}, nil
+	}
 
-		questionsWithOptions = append(questionsWithOptions, models.QuestionWithOptions{
+	result := QuestionsPage{
+		Questions:     make([]models.QuestionWithOptions, 0, len(questions.Questions)),
+		NextPageToken: questions.NextPageToken,
+	}
+	for _, q := range questions.Questions {
+		result.Questions = append(result.Questions, models.QuestionWithOptions{
 			Question: q,
-			Options:  options,
+			Options:  options[q.QuestionID],
 		})
 	}
 
 	// Return the questions with options
-	resultJSON, _ := json.Marshal(questionsWithOptions)
+	resultJSON, _ := json.Marshal(result)
 	return events.APIGatewayProxyResponse{
 		StatusCode: http.StatusOK,
 		Body:       string(resultJSON),
@@ -589,9 +635,9 @@ func GetQuestionsByQuiz(request events.APIGatewayProxyRequest) (events.APIGatewa
 }
 
 // Helper function to get a question with its options
-func getQuestionWithOptions(questionID string) (*models.QuestionWithOptions, error) {
+func (h *Handler) getQuestionWithOptions(ctx context.Context, questionID string) (*models.QuestionWithOptions, error) {
 	// Fetch the question
-	question, err := database.GetQuestionByID(questionID)
+	question, err := h.Questions.GetQuestionByID(ctx, questionID)
 	if err != nil {
 		return nil, err
 	}
@@ -599,7 +645,7 @@ func getQuestionWithOptions(questionID string) (*models.QuestionWithOptions, err
 	// Fetch options if applicable
 	var options []models.Option
 	if question.QuestionType == models.QuestionTypeMCQ || question.QuestionType == models.QuestionTypeTrueFalse {
-		options, err = database.GetOptionsByQuestionID(questionID)
+		options, err = h.Options.GetOptionsByQuestionID(ctx, questionID)
 		if err != nil {
 			log.Printf("Error fetching options: %v", err)
 			// Continue even if options can't be fetched
@@ -14,6 +14,9 @@ import (
 	"github.com/Aditya-PS-05/NeetChamp-question-bank-service/bank-service/handlers"
 )
 
+// bank serves the routes; main gives it the store from QUESTION_BANK_STORE
+var bank *handlers.Handler
+
 func handler(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	log.Printf("Processing request: Method=%s, Path=%s, Resource=%s",
 		request.HTTPMethod, request.Path, request.Resource)
@@ -37,33 +40,43 @@ func handler(ctx context.Context, request events.APIGatewayProxyRequest) (events
 
 	// Direct routing based on HTTP method and path pattern
 	switch {
+	// GET /api/quiz/{quizId}/questions
+	case httpMethod == "GET" && strings.HasPrefix(path, "/api/quiz/") && strings.HasSuffix(path, "/questions"):
+		if quizId := extractQuizId(path); quizId != "" {
+			if request.PathParameters == nil {
+				request.PathParameters = map[string]string{}
+			}
+			request.PathParameters["quizId"] = quizId
+			return bank.GetQuestionsByQuiz(ctx, request)
+		}
+
 	// GET /api/question/{questionId}
 	case httpMethod == "GET" && strings.HasPrefix(path, "/api/question/"):
 		questionId := extractQuestionId(path)
 		if questionId != "" {
 			log.Printf("GET question with ID: %s", questionId)
-			return handlers.GetQuestion(request)
+			return bank.GetQuestion(ctx, request)
 		}
 
 	// POST /api/question/add
 	case httpMethod == "POST" && (strings.HasSuffix(path, "/api/question/add") ||
 		strings.HasSuffix(path, "/question/add")):
-		return handlers.AddQuestion(request)
+		return bank.AddQuestion(ctx, request)
 
 	// Alternative POST handling for just /api/question
 	case httpMethod == "POST" && (path == "/api/question" || path == "/question"):
-		return handlers.AddQuestion(request)
+		return bank.AddQuestion(ctx, request)
 
 	// POST with quiz_id in body (fallback)
 	case httpMethod == "POST" && strings.Contains(request.Body, "quiz_id"):
-		return handlers.AddQuestion(request)
+		return bank.AddQuestion(ctx, request)
 
 	// DELETE /api/question/{questionId}/delete
 	case httpMethod == "DELETE" && strings.Contains(path, "/delete"):
-		return handlers.DeleteQuestion(request)
+		return bank.DeleteQuestion(ctx, request)
 
 	default:
-		return handlers.UpdateQuestion(request)
+		return bank.UpdateQuestion(ctx, request)
 	}
 
 	// Check for question ID in path parameters
@@ -72,7 +85,7 @@ func handler(ctx context.Context, request events.APIGatewayProxyRequest) (events
 		// Create a new request with modified path for consistent processing
 		modifiedRequest := request
 		modifiedRequest.Path = "/api/question/" + questionId
-		return handlers.GetQuestion(modifiedRequest)
+		return bank.GetQuestion(ctx, modifiedRequest)
 	}
 
 	// Default response for unmatched routes
@@ -113,8 +126,21 @@ func extractQuestionId(path string) string {
 	return ""
 }
 
+// Extract quiz ID from /api/quiz/{quizId}/questions
+func extractQuizId(path string) string {
+	matches := regexp.MustCompile(`/api/quiz/([^/]+)/questions$`).FindStringSubmatch(path)
+	if len(matches) > 1 {
+		return matches[1]
+	}
+	return ""
+}
+
 func main() {
-	database.InitDynamoDB()
+	store, err := database.Open(context.Background())
+	if err != nil {
+		log.Fatalf("❌ Failed to open the question store: %v", err)
+	}
+	bank = handlers.NewHandler(store)
 	fmt.Println("🚀 NeetChamp Question Bank Service Started!")
 	lambda.Start(handler)
 }
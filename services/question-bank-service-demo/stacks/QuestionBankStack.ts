@@ -55,6 +55,7 @@ export function QuestionBankStack({ stack }: StackContext) {
       "GET /api/question/{questionId}": questionBankFunction,
       "PUT /api/question/{questionId}/update": questionBankFunction,
       "DELETE /api/question/{questionId}/delete": questionBankFunction,
+      "GET /api/quiz/{quizId}/questions": questionBankFunction,
     },
   });
 
@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	authzpb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/authz"
+	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/user"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Activities may be reported late, but not from the future
+const maxClockSkew = 5 * time.Minute
+
+// RecordActivity credits a quiz or practice session to a student: XP, level,
+// streaks and counters are updated and newly reached badges awarded, all in
+// one transaction. A retried activity_id changes nothing.
+func (c *UserController) RecordActivity(ctx context.Context, req *pb.RecordActivityRequest) (*pb.RecordActivityResponse, error) {
+	if req.QuestionsAnswered < 0 || req.CorrectAnswers < 0 || req.CorrectAnswers > req.QuestionsAnswered {
+		return nil, apperr.InvalidField("correct_answers", "correct_answers must be between 0 and questions_answered")
+	}
+	if len(req.ActivityId) > 128 {
+		return nil, apperr.InvalidField("activity_id", "activity_id is too long (max 128 characters)")
+	}
+	occurredAt := time.Now()
+	if req.OccurredAt != nil {
+		occurredAt = req.OccurredAt.AsTime()
+		if occurredAt.After(time.Now().Add(maxClockSkew)) {
+			return nil, apperr.InvalidField("occurred_at", "occurred_at is in the future")
+		}
+	}
+
+	var user models.User
+	if err := c.DB.Select("id, role").First(&user, "id = ?", targetUser(ctx, req.UserId)).Error; err != nil {
+		return nil, errUserNotFound
+	}
+	if authz.ParseRole(user.Role) != authzpb.Role_ROLE_STUDENT {
+		return nil, wrongRole("progress is only tracked for students")
+	}
+	userID := strconv.FormatUint(uint64(user.ID), 10)
+
+	activityID := req.ActivityId
+	if activityID == "" {
+		activityID = newActivityID()
+	}
+
+	response := &pb.RecordActivityResponse{}
+	var mentee models.Mentee
+	var earned []models.UserBadge
+	err := c.DB.Transaction(func(tx *gorm.DB) error {
+		if err := database.EnsureProfile(tx, userID, authzpb.Role_ROLE_STUDENT); err != nil {
+			return err
+		}
+		// Concurrent activities of one student apply one after the other
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&mentee, "user_id = ?", userID).Error; err != nil {
+			return err
+		}
+
+		experience := c.Progress.Experience(int(req.CorrectAnswers), req.QuizCompleted)
+		claim := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.MenteeActivity{
+			UserID:            userID,
+			ID:                activityID,
+			QuestionsAnswered: int(req.QuestionsAnswered),
+			CorrectAnswers:    int(req.CorrectAnswers),
+			QuizCompleted:     req.QuizCompleted,
+			Experience:        experience,
+			OccurredAt:        occurredAt,
+		})
+		if claim.Error != nil {
+			return claim.Error
+		}
+		if claim.RowsAffected == 0 {
+			response.Duplicate = true
+			return nil
+		}
+
+		previousLevel := mentee.Level
+		mentee.Experience += experience
+		mentee.Level = c.Progress.Curve.Level(mentee.Experience)
+		mentee.CorrectAnswers += int(req.CorrectAnswers)
+		if req.QuizCompleted {
+			mentee.QuizzesPlayed++
+		}
+		streak, day := c.Progress.ExtendStreak(mentee.CurrentStreak, mentee.LastActiveOn, occurredAt)
+		mentee.CurrentStreak = streak
+		mentee.LongestStreak = max(mentee.LongestStreak, streak)
+		mentee.LastActiveOn = &day
+
+		err := tx.Model(&models.Mentee{}).Where("user_id = ?", userID).Updates(map[string]interface{}{
+			"experience":      mentee.Experience,
+			"level":           mentee.Level,
+			"correct_answers": mentee.CorrectAnswers,
+			"quizzes_played":  mentee.QuizzesPlayed,
+			"current_streak":  mentee.CurrentStreak,
+			"longest_streak":  mentee.LongestStreak,
+			"last_active_on":  day,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		response.ExperienceGained = int32(experience)
+		response.LeveledUp = mentee.Level > previousLevel
+		earned, err = database.AwardBadges(tx, mentee, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to record activity")
+	}
+
+	badges, err := database.UserBadges(c.DB, []string{userID})
+	if err != nil {
+		return nil, apperr.Internal("failed to load badges")
+	}
+	response.Mentee = c.menteeSummary(mentee, badges[userID])
+	for _, badge := range earned {
+		response.NewBadges = append(response.NewBadges, badgeSummary(badge))
+	}
+	return response, nil
+}
+
+func newActivityID() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,227 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/user"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/media"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// How long an upload URL from CreateUploadURL accepts the upload
+const uploadURLExpiry = 15 * time.Minute
+
+// CreateUploadURL hands out a presigned URL for uploading an avatar straight
+// to the object store, so large images don't pass through the gateway
+func (c *UserController) CreateUploadURL(ctx context.Context, req *pb.CreateUploadURLRequest) (*pb.CreateUploadURLResponse, error) {
+	if c.Media == nil {
+		return nil, errMediaDisabled
+	}
+	if !media.ContentTypes[req.ContentType] {
+		return nil, apperr.InvalidArgument(apperr.ReasonUnsupportedImageType, media.ErrUnsupportedType.Error()).WithField("content_type", media.ErrUnsupportedType.Error())
+	}
+	if req.SizeBytes <= 0 || req.SizeBytes > c.AvatarMaxBytes {
+		return nil, apperr.InvalidArgument(apperr.ReasonImageTooLarge, fmt.Sprintf("size_bytes must be between 1 and %d", c.AvatarMaxBytes)).
+			WithField("size_bytes", fmt.Sprintf("must be between 1 and %d", c.AvatarMaxBytes))
+	}
+	var user models.User
+	if err := c.DB.Select("id").First(&user, "id = ?", targetUser(ctx, req.UserId)).Error; err != nil {
+		return nil, errUserNotFound
+	}
+
+	uploadID := newUploadID()
+	expiresAt := time.Now().Add(uploadURLExpiry)
+	uploadURL, err := c.Media.PresignPut(ctx, uploadKey(strconv.FormatUint(uint64(user.ID), 10), uploadID), req.ContentType, uploadURLExpiry)
+	if err != nil {
+		return nil, apperr.Internal("failed to create upload URL")
+	}
+
+	return &pb.CreateUploadURLResponse{
+		UploadId:  uploadID,
+		UploadUrl: uploadURL,
+		Method:    "PUT",
+		Headers:   map[string]string{"Content-Type": req.ContentType},
+		ExpiresAt: timestamppb.New(expiresAt),
+		MaxBytes:  c.AvatarMaxBytes,
+	}, nil
+}
+
+// UploadAvatar validates an image, renders the avatar sizes into the object
+// store and replaces the user's previous avatar
+func (c *UserController) UploadAvatar(ctx context.Context, req *pb.UploadAvatarRequest) (*pb.UploadAvatarResponse, error) {
+	if c.Media == nil {
+		return nil, errMediaDisabled
+	}
+	var user models.User
+	if err := c.DB.Select("id").First(&user, "id = ?", targetUser(ctx, req.UserId)).Error; err != nil {
+		return nil, errUserNotFound
+	}
+	userID := strconv.FormatUint(uint64(user.ID), 10)
+
+	var data []byte
+	switch source := req.Source.(type) {
+	case *pb.UploadAvatarRequest_Image:
+		data = source.Image
+	case *pb.UploadAvatarRequest_UploadId:
+		if _, err := hex.DecodeString(source.UploadId); err != nil || len(source.UploadId) != 32 {
+			return nil, apperr.InvalidField("upload_id", "invalid upload_id")
+		}
+		key := uploadKey(userID, source.UploadId)
+		var err error
+		if data, err = c.readUpload(ctx, key); err != nil {
+			return nil, err
+		}
+		// The upload is only staging; it goes whether or not it's usable
+		defer c.deleteObjects(ctx, key)
+	default:
+		return nil, apperr.InvalidField("image", "image or upload_id is required")
+	}
+	if len(data) == 0 {
+		return nil, apperr.InvalidField("image", "image is empty")
+	}
+	if int64(len(data)) > c.AvatarMaxBytes {
+		return nil, apperr.InvalidArgument(apperr.ReasonImageTooLarge, fmt.Sprintf("image is larger than %d bytes", c.AvatarMaxBytes))
+	}
+
+	img, err := media.Decode(data)
+	if err != nil {
+		return nil, imageError(err)
+	}
+
+	avatar := models.Avatar{UserID: userID, Prefix: fmt.Sprintf("avatars/%s/%s", userID, newUploadID()), UpdatedAt: time.Now()}
+	for _, variant := range media.AvatarVariants {
+		encoded, err := media.EncodeJPEG(media.Square(img, variant.Size))
+		if err == nil {
+			err = c.Media.Put(ctx, media.AvatarKey(avatar.Prefix, variant), bytes.NewReader(encoded), int64(len(encoded)), "image/jpeg")
+		}
+		if err != nil {
+			log.Printf("⚠️ Failed to store avatar of user %s: %v", userID, err)
+			c.deleteObjects(ctx, media.AvatarKeys(avatar.Prefix)...)
+			return nil, apperr.Internal("failed to store avatar")
+		}
+	}
+
+	var previous models.Avatar
+	err = c.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&previous, "user_id = ?", userID).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&avatar).Error
+	})
+	if err != nil {
+		c.deleteObjects(ctx, media.AvatarKeys(avatar.Prefix)...)
+		return nil, apperr.Internal("failed to save avatar")
+	}
+	if previous.Prefix != "" {
+		c.deleteObjects(ctx, media.AvatarKeys(previous.Prefix)...)
+	}
+
+	return &pb.UploadAvatarResponse{Avatar: c.avatarSummary(avatar), Message: "Avatar updated successfully"}, nil
+}
+
+// DeleteAvatar removes the user's avatar and its renditions
+func (c *UserController) DeleteAvatar(ctx context.Context, req *pb.DeleteAvatarRequest) (*pb.DeleteAvatarResponse, error) {
+	if c.Media == nil {
+		return nil, errMediaDisabled
+	}
+	var avatar models.Avatar
+	err := c.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&avatar, "user_id = ?", targetUser(ctx, req.UserId)).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&avatar).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errAvatarNotFound
+	}
+	if err != nil {
+		return nil, apperr.Internal("failed to delete avatar")
+	}
+	c.deleteObjects(ctx, media.AvatarKeys(avatar.Prefix)...)
+
+	return &pb.DeleteAvatarResponse{Message: "Avatar deleted successfully"}, nil
+}
+
+// readUpload reads a direct upload, refusing more than AvatarMaxBytes
+func (c *UserController) readUpload(ctx context.Context, key string) ([]byte, error) {
+	object, err := c.Media.Get(ctx, key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errUploadNotFound
+	}
+	if err != nil {
+		return nil, apperr.Internal("failed to read upload")
+	}
+	defer object.Close()
+
+	data, err := io.ReadAll(io.LimitReader(object, c.AvatarMaxBytes+1))
+	if err != nil {
+		return nil, apperr.Internal("failed to read upload")
+	}
+	return data, nil
+}
+
+// imageError reports why media.Decode refused an image
+func imageError(err error) error {
+	switch {
+	case errors.Is(err, media.ErrUnsupportedType):
+		return apperr.InvalidArgument(apperr.ReasonUnsupportedImageType, err.Error())
+	case errors.Is(err, media.ErrTooLarge):
+		return apperr.InvalidArgument(apperr.ReasonImageTooLarge, err.Error())
+	default:
+		return apperr.InvalidArgument(apperr.ReasonInvalidImage, err.Error())
+	}
+}
+
+// deleteObjects removes objects on a best-effort basis; leftovers only cost storage
+func (c *UserController) deleteObjects(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := c.Media.Delete(ctx, key); err != nil {
+			log.Printf("⚠️ Failed to delete %s: %v", key, err)
+		}
+	}
+}
+
+func (c *UserController) avatarSummary(avatar models.Avatar) *pb.Avatar {
+	if c.Media == nil {
+		return nil
+	}
+	summary := &pb.Avatar{UpdatedAt: timestamppb.New(avatar.UpdatedAt)}
+	for _, variant := range media.AvatarVariants {
+		url := c.Media.URL(media.AvatarKey(avatar.Prefix, variant))
+		switch variant.Name {
+		case "large":
+			summary.Url = url
+		case "medium":
+			summary.ThumbnailUrl = url
+		case "small":
+			summary.IconUrl = url
+		}
+	}
+	return summary
+}
+
+// uploadKey is where a direct upload is staged until UploadAvatar processes it
+func uploadKey(userID, uploadID string) string {
+	return fmt.Sprintf("uploads/%s/%s", userID, uploadID)
+}
+
+func newUploadID() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,359 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/audit"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	authzpb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/authz"
+	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/user"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Invite codes avoid look-alike characters; 32 symbols keep rand bytes unbiased
+const (
+	inviteAlphabet   = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	inviteCodeLength = 8
+)
+
+// CreateCohort creates a cohort owned by the caller, or by another mentor when an admin asks
+func (c *UserController) CreateCohort(ctx context.Context, req *pb.CreateCohortRequest) (*pb.CreateCohortResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len([]rune(name)) > 255 {
+		return nil, apperr.InvalidField("name", "a cohort name of up to 255 characters is required")
+	}
+
+	mentorID := authz.UserID(ctx)
+	if req.MentorId != "" && req.MentorId != mentorID {
+		if !isAdmin(ctx) {
+			return nil, apperr.PermissionDenied("only admins can create cohorts for other mentors")
+		}
+		var mentor models.User
+		if err := c.DB.Select("id, role").First(&mentor, "id = ?", req.MentorId).Error; err != nil {
+			return nil, errMentorNotFound
+		}
+		if role := authz.ParseRole(mentor.Role); role != authzpb.Role_ROLE_MENTOR && role != authzpb.Role_ROLE_ADMIN {
+			return nil, wrongRole("cohorts can only be owned by mentors and admins")
+		}
+		mentorID = strconv.FormatUint(uint64(mentor.ID), 10)
+	}
+
+	// Retry the rare invite code collision
+	var cohort models.Cohort
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		var code string
+		if code, err = newInviteCode(); err != nil {
+			break
+		}
+		cohort = models.Cohort{Name: name, MentorID: mentorID, InviteCode: code}
+		if err = c.DB.Create(&cohort).Error; !isUniqueViolation(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, apperr.Internal("failed to create cohort")
+	}
+
+	return &pb.CreateCohortResponse{Cohort: cohortSummary(cohort, 0, true)}, nil
+}
+
+// ListCohorts pages through the caller's cohorts; admins may list any mentor's or all
+func (c *UserController) ListCohorts(ctx context.Context, req *pb.ListCohortsRequest) (*pb.ListCohortsResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	query := c.DB.Model(&models.Cohort{})
+	switch mentorID := req.MentorId; {
+	case mentorID == "" || mentorID == authz.UserID(ctx):
+		query = query.Where("mentor_id = ?", authz.UserID(ctx))
+	case !isAdmin(ctx):
+		return nil, apperr.PermissionDenied("only admins can list other mentors' cohorts")
+	case mentorID != "*":
+		query = query.Where("mentor_id = ?", mentorID)
+	}
+	// The page token is the last cohort ID of the previous page
+	if req.PageToken != "" {
+		cursor, err := strconv.ParseUint(req.PageToken, 10, 64)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		query = query.Where("id > ?", cursor)
+	}
+
+	var cohorts []models.Cohort
+	if err := query.Order("id").Limit(pageSize + 1).Find(&cohorts).Error; err != nil {
+		return nil, apperr.Internal("failed to list cohorts")
+	}
+
+	response := &pb.ListCohortsResponse{}
+	if len(cohorts) > pageSize {
+		cohorts = cohorts[:pageSize]
+		response.NextPageToken = strconv.FormatUint(uint64(cohorts[pageSize-1].ID), 10)
+	}
+
+	ids := make([]uint, 0, len(cohorts))
+	for _, cohort := range cohorts {
+		ids = append(ids, cohort.ID)
+	}
+	counts, err := memberCounts(c.DB, ids)
+	if err != nil {
+		return nil, apperr.Internal("failed to list cohorts")
+	}
+
+	response.Cohorts = make([]*pb.Cohort, 0, len(cohorts))
+	for _, cohort := range cohorts {
+		response.Cohorts = append(response.Cohorts, cohortSummary(cohort, counts[cohort.ID], true))
+	}
+	return response, nil
+}
+
+// JoinCohort adds the calling student to the cohort with the invite code
+func (c *UserController) JoinCohort(ctx context.Context, req *pb.JoinCohortRequest) (*pb.JoinCohortResponse, error) {
+	if authz.ParseRole(authz.UserRole(ctx)) != authzpb.Role_ROLE_STUDENT {
+		return nil, apperr.PermissionDenied("only students can join cohorts")
+	}
+	userID := authz.UserID(ctx)
+
+	var cohort models.Cohort
+	code := strings.ToUpper(strings.TrimSpace(req.InviteCode))
+	if err := c.DB.First(&cohort, "invite_code = ?", code).Error; err != nil {
+		return nil, errInvalidInviteCode
+	}
+
+	var membership models.CohortMember
+	err := c.DB.First(&membership, "user_id = ?", userID).Error
+	switch {
+	case err == nil && membership.CohortID == cohort.ID:
+		return &pb.JoinCohortResponse{Cohort: cohortSummary(cohort, 0, false), Message: "Already a member of this cohort"}, nil
+	case err == nil:
+		return nil, errAlreadyInCohort
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errDatabase
+	}
+
+	err = c.DB.Transaction(func(tx *gorm.DB) error {
+		if err := database.EnsureProfile(tx, userID, authzpb.Role_ROLE_STUDENT); err != nil {
+			return err
+		}
+		return tx.Create(&models.CohortMember{UserID: userID, CohortID: cohort.ID, JoinedAt: time.Now()}).Error
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to join cohort")
+	}
+
+	return &pb.JoinCohortResponse{Cohort: cohortSummary(cohort, 0, false), Message: "Joined cohort successfully"}, nil
+}
+
+// ListCohortMembers pages through a cohort's students with their mentee stats
+func (c *UserController) ListCohortMembers(ctx context.Context, req *pb.ListCohortMembersRequest) (*pb.ListCohortMembersResponse, error) {
+	cohort, err := c.managedCohort(ctx, req.CohortId)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// The page token is the last user ID of the previous page
+	query := c.DB.Where("cohort_id = ?", cohort.ID)
+	if req.PageToken != "" {
+		query = query.Where("user_id > ?", req.PageToken)
+	}
+	var memberships []models.CohortMember
+	if err := query.Order("user_id").Limit(pageSize + 1).Find(&memberships).Error; err != nil {
+		return nil, apperr.Internal("failed to list cohort members")
+	}
+
+	response := &pb.ListCohortMembersResponse{}
+	if len(memberships) > pageSize {
+		memberships = memberships[:pageSize]
+		response.NextPageToken = memberships[pageSize-1].UserID
+	}
+	if len(memberships) == 0 {
+		return response, nil
+	}
+
+	userIDs := make([]string, 0, len(memberships))
+	for _, membership := range memberships {
+		userIDs = append(userIDs, membership.UserID)
+	}
+	var users []models.User
+	var mentees []models.Mentee
+	badges, err := database.UserBadges(c.DB, userIDs)
+	err = errors.Join(
+		err,
+		c.DB.Select("id, name, email, role, email_verified, created_at").Where("id IN ?", userIDs).Find(&users).Error,
+		c.DB.Where("user_id IN ?", userIDs).Find(&mentees).Error,
+	)
+	if err != nil {
+		return nil, apperr.Internal("failed to list cohort members")
+	}
+	usersByID := make(map[string]models.User, len(users))
+	for _, user := range users {
+		usersByID[strconv.FormatUint(uint64(user.ID), 10)] = user
+	}
+	menteesByID := make(map[string]models.Mentee, len(mentees))
+	for _, mentee := range mentees {
+		menteesByID[mentee.UserID] = mentee
+	}
+
+	response.Members = make([]*pb.CohortMember, 0, len(memberships))
+	for _, membership := range memberships {
+		user, ok := usersByID[membership.UserID]
+		if !ok {
+			continue // Deleted users keep their membership until erased
+		}
+		member := &pb.CohortMember{User: userSummary(user), JoinedAt: timestamppb.New(membership.JoinedAt)}
+		if mentee, ok := menteesByID[membership.UserID]; ok {
+			member.Mentee = c.menteeSummary(mentee, badges[membership.UserID])
+		}
+		response.Members = append(response.Members, member)
+	}
+	return response, nil
+}
+
+// TransferStudent moves a student into another cohort. Placing a student in
+// their first cohort is up to admins; everyone else joins with JoinCohort.
+func (c *UserController) TransferStudent(ctx context.Context, req *pb.TransferStudentRequest) (*pb.TransferStudentResponse, error) {
+	to, err := c.managedCohort(ctx, req.ToCohortId)
+	if err != nil {
+		return nil, err
+	}
+
+	var student models.User
+	if err := c.DB.Select("id, role").First(&student, "id = ?", req.UserId).Error; err != nil {
+		return nil, errUserNotFound
+	}
+	if authz.ParseRole(student.Role) != authzpb.Role_ROLE_STUDENT {
+		return nil, wrongRole("only students can be in cohorts")
+	}
+	userID := strconv.FormatUint(uint64(student.ID), 10)
+
+	// Moving a student out of a cohort requires owning it too
+	fromCohortID := ""
+	var membership models.CohortMember
+	err = c.DB.First(&membership, "user_id = ?", userID).Error
+	if err == nil {
+		fromCohortID = strconv.FormatUint(uint64(membership.CohortID), 10)
+		if membership.CohortID == to.ID {
+			return &pb.TransferStudentResponse{Message: "Student is already in this cohort", FromCohortId: fromCohortID}, nil
+		}
+		if _, err := c.managedCohort(ctx, fromCohortID); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errDatabase
+	} else if !isAdmin(ctx) {
+		// A mentor could otherwise pull in any student; they join with an invite code
+		return nil, apperr.PermissionDenied("only admins can place a student in their first cohort; the student can join with its invite code")
+	}
+
+	err = c.DB.Transaction(func(tx *gorm.DB) error {
+		if err := database.EnsureProfile(tx, userID, authzpb.Role_ROLE_STUDENT); err != nil {
+			return err
+		}
+		return tx.Save(&models.CohortMember{UserID: userID, CohortID: to.ID, JoinedAt: time.Now()}).Error
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to transfer student")
+	}
+
+	audit.Record(ctx, c.DB, audit.Event{
+		Type:     audit.StudentTransferred,
+		TargetID: userID,
+		Details:  map[string]interface{}{"from_cohort_id": fromCohortID, "to_cohort_id": req.ToCohortId},
+	})
+	return &pb.TransferStudentResponse{Message: "Student transferred successfully", FromCohortId: fromCohortID}, nil
+}
+
+// managedCohort loads a cohort the caller owns; admins manage every cohort
+func (c *UserController) managedCohort(ctx context.Context, cohortID string) (models.Cohort, error) {
+	var cohort models.Cohort
+	id, err := strconv.ParseUint(cohortID, 10, 64)
+	if err != nil {
+		return cohort, errCohortNotFound
+	}
+	if err := c.DB.First(&cohort, id).Error; err != nil {
+		return cohort, errCohortNotFound
+	}
+	if cohort.MentorID != authz.UserID(ctx) && !isAdmin(ctx) {
+		return cohort, errCohortNotFound // Don't reveal other mentors' cohorts
+	}
+	return cohort, nil
+}
+
+// memberCounts counts the members of each cohort in one query
+func memberCounts(db *gorm.DB, cohortIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(cohortIDs))
+	if len(cohortIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		CohortID uint
+		Count    int64
+	}
+	err := db.Model(&models.CohortMember{}).
+		Select("cohort_id, COUNT(*) AS count").
+		Where("cohort_id IN ?", cohortIDs).
+		Group("cohort_id").
+		Scan(&rows).Error
+	for _, row := range rows {
+		counts[row.CohortID] = row.Count
+	}
+	return counts, err
+}
+
+func cohortSummary(cohort models.Cohort, members int64, withInviteCode bool) *pb.Cohort {
+	summary := &pb.Cohort{
+		CohortId:    strconv.FormatUint(uint64(cohort.ID), 10),
+		Name:        cohort.Name,
+		MentorId:    cohort.MentorID,
+		MemberCount: int32(members),
+		CreatedAt:   timestamppb.New(cohort.CreatedAt),
+	}
+	if withInviteCode {
+		summary.InviteCode = cohort.InviteCode
+	}
+	return summary
+}
+
+func newInviteCode() (string, error) {
+	code := make([]byte, inviteCodeLength)
+	if _, err := rand.Read(code); err != nil {
+		return "", err
+	}
+	for i, b := range code {
+		code[i] = inviteAlphabet[int(b)%len(inviteAlphabet)]
+	}
+	return string(code), nil
+}
+
+// isAdmin reports whether the caller's token carries the admin role
+func isAdmin(ctx context.Context) bool {
+	return authz.ParseRole(authz.UserRole(ctx)) == authzpb.Role_ROLE_ADMIN
+}
+
+// isUniqueViolation detects Postgres unique_violation errors
+func isUniqueViolation(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "SQLSTATE 23505")
+}
@@ -0,0 +1,28 @@
+package controllers
+
+import "github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+
+// Errors the RPCs return; clients switch on their reason (see shared-libs/apperr)
+var (
+	errDatabase         = apperr.Internal("database error")
+	errUserNotFound     = apperr.NotFound(apperr.ReasonUserNotFound, "user not found")
+	errEmailTaken       = apperr.AlreadyExists(apperr.ReasonEmailTaken, "email already in use")
+	errInvalidPageToken = apperr.InvalidArgument(apperr.ReasonInvalidPageToken, "invalid page token").WithField("page_token", "not a token from a previous page")
+	errStaleETag        = apperr.Aborted(apperr.ReasonUserModified, "user was modified since it was read; fetch it again and retry")
+	errNothingToRestore = apperr.NotFound(apperr.ReasonNothingToRestore, "no deleted user to restore (it may have been erased already)")
+	errOnboardingNeeded = apperr.FailedPrecondition(apperr.ReasonOnboardingRequired, "complete onboarding before updating the academic profile")
+
+	errMentorNotFound    = apperr.NotFound(apperr.ReasonMentorNotFound, "mentor not found")
+	errCohortNotFound    = apperr.NotFound(apperr.ReasonCohortNotFound, "cohort not found")
+	errInvalidInviteCode = apperr.NotFound(apperr.ReasonInvalidInviteCode, "invalid invite code")
+	errAlreadyInCohort   = apperr.FailedPrecondition(apperr.ReasonAlreadyInCohort, "already in another cohort; ask your mentor to transfer you")
+
+	errMediaDisabled  = apperr.Unimplemented(apperr.ReasonMediaDisabled, "avatar uploads are not configured")
+	errAvatarNotFound = apperr.NotFound(apperr.ReasonAvatarNotFound, "user has no avatar")
+	errUploadNotFound = apperr.FailedPrecondition(apperr.ReasonUploadNotFound, "nothing was uploaded for this upload_id")
+)
+
+// wrongRole rejects a request that doesn't apply to the user's role
+func wrongRole(message string) error {
+	return apperr.InvalidArgument(apperr.ReasonWrongRole, message)
+}
@@ -0,0 +1,254 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/audit"
+	authModels "github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/user"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// userArchive is the document ExportUserData returns
+type userArchive struct {
+	GeneratedAt    time.Time              `json:"generated_at"`
+	Account        archiveAccount         `json:"account"`
+	Mentee         *archiveMentee         `json:"mentee,omitempty"`
+	Mentor         *archiveMentor         `json:"mentor,omitempty"`
+	Academic       *archiveAcademic       `json:"academic,omitempty"`
+	Avatar         *archiveAvatar         `json:"avatar,omitempty"`
+	Cohort         *archiveCohort         `json:"cohort,omitempty"`      // The cohort the student is in
+	Permissions    []string               `json:"permissions,omitempty"` // Grants on top of the role
+	LinkedAccounts []archiveLinkedAccount `json:"linked_accounts"`
+	Sessions       []archiveSession       `json:"sessions"`
+	AuditTrail     []archiveAuditEvent    `json:"audit_trail"`
+}
+
+type archiveAccount struct {
+	UserID        string     `json:"user_id"`
+	Name          string     `json:"name"`
+	Email         string     `json:"email"`
+	Role          string     `json:"role"`
+	EmailVerified bool       `json:"email_verified"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+}
+
+type archiveMentee struct {
+	Experience     int            `json:"experience"`
+	Level          int            `json:"level"`
+	CurrentStreak  int            `json:"current_streak"`
+	LongestStreak  int            `json:"longest_streak"`
+	QuizzesPlayed  int            `json:"quizzes_played"`
+	CorrectAnswers int            `json:"correct_answers"`
+	Badges         []archiveBadge `json:"badges"`
+}
+
+type archiveBadge struct {
+	BadgeID  string    `json:"badge_id"`
+	Name     string    `json:"name"`
+	EarnedAt time.Time `json:"earned_at"`
+}
+
+type archiveMentor struct {
+	Bio     string   `json:"bio"`
+	Cohorts []string `json:"cohorts"` // Names of the cohorts they own
+}
+
+type archiveAcademic struct {
+	TargetYear        int       `json:"target_year"`
+	Attempt           int       `json:"attempt"`
+	State             string    `json:"state"`
+	Category          string    `json:"category"`
+	PwBD              bool      `json:"pwbd"`
+	PreferredLanguage string    `json:"preferred_language"`
+	CoachingInstitute string    `json:"coaching_institute"`
+	WeakSubjects      []string  `json:"weak_subjects"`
+	StrongSubjects    []string  `json:"strong_subjects"`
+	DailyStudyMinutes int       `json:"daily_study_minutes"`
+	OnboardedAt       time.Time `json:"onboarded_at"`
+}
+
+type archiveAvatar struct {
+	URL       string    `json:"url"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type archiveCohort struct {
+	Name     string    `json:"name"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type archiveLinkedAccount struct {
+	Provider    string    `json:"provider"`
+	Email       string    `json:"email"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastLoginAt time.Time `json:"last_login_at"`
+}
+
+type archiveSession struct {
+	DeviceName string     `json:"device_name"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+type archiveAuditEvent struct {
+	Type      string          `json:"type"`
+	ActorID   string          `json:"actor_id"`
+	TargetID  string          `json:"target_id"`
+	IPAddress string          `json:"ip_address"`
+	Details   json.RawMessage `json:"details"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// ExportUserData bundles everything stored about a user into a JSON archive.
+// Deleted users can still be exported until they are erased.
+func (c *UserController) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	var user models.User
+	if err := c.DB.Unscoped().First(&user, "id = ?", targetUser(ctx, req.UserId)).Error; err != nil {
+		return nil, errUserNotFound
+	}
+	userID := strconv.FormatUint(uint64(user.ID), 10)
+
+	archive := userArchive{
+		GeneratedAt: time.Now().UTC(),
+		Account: archiveAccount{
+			UserID:        userID,
+			Name:          user.Name,
+			Email:         user.Email,
+			Role:          user.Role,
+			EmailVerified: user.EmailVerified,
+			CreatedAt:     user.CreatedAt,
+			UpdatedAt:     user.UpdatedAt,
+		},
+		LinkedAccounts: []archiveLinkedAccount{},
+		Sessions:       []archiveSession{},
+		AuditTrail:     []archiveAuditEvent{},
+	}
+	if user.DeletedAt.Valid {
+		archive.Account.DeletedAt = &user.DeletedAt.Time
+	}
+
+	var mentee models.Mentee
+	if err := c.DB.First(&mentee, "user_id = ?", userID).Error; err == nil {
+		archive.Mentee = &archiveMentee{
+			Experience:     mentee.Experience,
+			Level:          mentee.Level,
+			CurrentStreak:  mentee.CurrentStreak,
+			LongestStreak:  mentee.LongestStreak,
+			QuizzesPlayed:  mentee.QuizzesPlayed,
+			CorrectAnswers: mentee.CorrectAnswers,
+			Badges:         []archiveBadge{},
+		}
+		badges, err := database.UserBadges(c.DB, []string{userID})
+		if err != nil {
+			return nil, apperr.Internal("failed to export user data")
+		}
+		for _, badge := range badges[userID] {
+			archive.Mentee.Badges = append(archive.Mentee.Badges, archiveBadge{BadgeID: badge.BadgeID, Name: badge.Badge.Name, EarnedAt: badge.EarnedAt})
+		}
+	}
+
+	var mentor models.Mentor
+	if err := c.DB.First(&mentor, "user_id = ?", userID).Error; err == nil {
+		archive.Mentor = &archiveMentor{Bio: mentor.Bio, Cohorts: []string{}}
+		c.DB.Model(&models.Cohort{}).Where("mentor_id = ?", userID).Order("id").Pluck("name", &archive.Mentor.Cohorts)
+	}
+
+	var academic models.AcademicProfile
+	if err := c.DB.First(&academic, "user_id = ?", userID).Error; err == nil {
+		archive.Academic = &archiveAcademic{
+			TargetYear:        academic.TargetYear,
+			Attempt:           academic.Attempt,
+			State:             academic.State,
+			Category:          academic.Category,
+			PwBD:              academic.PwBD,
+			PreferredLanguage: academic.PreferredLanguage,
+			CoachingInstitute: academic.CoachingInstitute,
+			WeakSubjects:      academic.WeakSubjects,
+			StrongSubjects:    academic.StrongSubjects,
+			DailyStudyMinutes: academic.DailyStudyMinutes,
+			OnboardedAt:       academic.OnboardedAt,
+		}
+	}
+
+	var avatar models.Avatar
+	if err := c.DB.First(&avatar, "user_id = ?", userID).Error; err == nil && c.Media != nil {
+		archive.Avatar = &archiveAvatar{URL: c.avatarSummary(avatar).Url, UpdatedAt: avatar.UpdatedAt}
+	}
+
+	var membership models.CohortMember
+	if err := c.DB.Preload("Cohort").First(&membership, "user_id = ?", userID).Error; err == nil {
+		archive.Cohort = &archiveCohort{Name: membership.Cohort.Name, JoinedAt: membership.JoinedAt}
+	}
+
+	permissions, err := database.UserGrants(c.DB, userID)
+	if err != nil {
+		return nil, apperr.Internal("failed to export user data")
+	}
+	for _, permission := range permissions {
+		archive.Permissions = append(archive.Permissions, permission.String())
+	}
+
+	var linked []authModels.LinkedAccount
+	var sessions []authModels.Session
+	var events []authModels.AuditEvent
+	err = errors.Join(
+		c.DB.Where("user_id = ?", user.ID).Order("created_at").Find(&linked).Error,
+		c.DB.Where("user_id = ?", user.ID).Order("created_at").Find(&sessions).Error,
+		c.DB.Where("actor_id = ? OR target_id = ?", userID, userID).Order("id").Find(&events).Error,
+	)
+	if err != nil {
+		return nil, apperr.Internal("failed to export user data")
+	}
+	for _, account := range linked {
+		archive.LinkedAccounts = append(archive.LinkedAccounts, archiveLinkedAccount{
+			Provider:    account.Provider,
+			Email:       account.Email,
+			CreatedAt:   account.CreatedAt,
+			LastLoginAt: account.LastLoginAt,
+		})
+	}
+	for _, session := range sessions {
+		archive.Sessions = append(archive.Sessions, archiveSession{
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			RevokedAt:  session.RevokedAt,
+		})
+	}
+	for _, event := range events {
+		archive.AuditTrail = append(archive.AuditTrail, archiveAuditEvent{
+			Type:      event.Type,
+			ActorID:   event.ActorID,
+			TargetID:  event.TargetID,
+			IPAddress: event.IPAddress,
+			Details:   json.RawMessage(event.Details),
+			CreatedAt: event.CreatedAt,
+		})
+	}
+
+	data, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return nil, apperr.Internal("failed to export user data")
+	}
+	audit.Record(ctx, c.DB, audit.Event{Type: audit.DataExported, TargetID: userID})
+
+	return &pb.ExportUserDataResponse{
+		Archive:     string(data),
+		GeneratedAt: timestamppb.New(archive.GeneratedAt),
+	}, nil
+}
@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	authzpb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/authz"
+	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/user"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// academicFields are the AcademicProfile columns a client sets
+var academicFields = []string{
+	"target_year",
+	"attempt",
+	"state",
+	"category",
+	"pwbd",
+	"preferred_language",
+	"coaching_institute",
+	"weak_subjects",
+	"strong_subjects",
+	"daily_study_minutes",
+}
+
+// indianStates are the ISO 3166-2:IN states and union territories
+var indianStates = map[string]string{
+	"AN": "Andaman and Nicobar Islands", "AP": "Andhra Pradesh", "AR": "Arunachal Pradesh",
+	"AS": "Assam", "BR": "Bihar", "CG": "Chhattisgarh", "CH": "Chandigarh",
+	"DH": "Dadra and Nagar Haveli and Daman and Diu", "DL": "Delhi", "GA": "Goa",
+	"GJ": "Gujarat", "HP": "Himachal Pradesh", "HR": "Haryana", "JH": "Jharkhand",
+	"JK": "Jammu and Kashmir", "KA": "Karnataka", "KL": "Kerala", "LA": "Ladakh",
+	"LD": "Lakshadweep", "MH": "Maharashtra", "ML": "Meghalaya", "MN": "Manipur",
+	"MP": "Madhya Pradesh", "MZ": "Mizoram", "NL": "Nagaland", "OD": "Odisha",
+	"PB": "Punjab", "PY": "Puducherry", "RJ": "Rajasthan", "SK": "Sikkim",
+	"TN": "Tamil Nadu", "TR": "Tripura", "TS": "Telangana", "UK": "Uttarakhand",
+	"UP": "Uttar Pradesh", "WB": "West Bengal",
+}
+
+const (
+	maxAttempts          = 10
+	maxTargetYearsAhead  = 4
+	maxDailyStudyMinutes = 16 * 60
+)
+
+// CompleteOnboarding saves a student's academic profile. Calling it again
+// replaces every field but keeps the original onboarding time.
+func (c *UserController) CompleteOnboarding(ctx context.Context, req *pb.CompleteOnboardingRequest) (*pb.CompleteOnboardingResponse, error) {
+	if req.Academic == nil {
+		return nil, apperr.InvalidField("academic", "academic is required")
+	}
+	var user models.User
+	if err := c.DB.Select("id, role").First(&user, "id = ?", targetUser(ctx, req.UserId)).Error; err != nil {
+		return nil, errUserNotFound
+	}
+	if authz.ParseRole(user.Role) != authzpb.Role_ROLE_STUDENT {
+		return nil, wrongRole("academic profiles are only kept for students")
+	}
+	userID := strconv.FormatUint(uint64(user.ID), 10)
+
+	profile, err := academicProfile(req.Academic, academicFields)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSubjects(profile.WeakSubjects, profile.StrongSubjects); err != nil {
+		return nil, err
+	}
+	profile.UserID = userID
+	profile.OnboardedAt = time.Now()
+
+	err = c.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns(append(slices.Clone(academicFields), "updated_at")),
+		}).Create(&profile).Error
+		if err != nil {
+			return err
+		}
+		// The profile is part of the user, so their etag changes
+		return tx.Model(&models.User{}).Where("id = ?", user.ID).Update("version", gorm.Expr("version + 1")).Error
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to save academic profile")
+	}
+
+	updated, err := c.loadUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CompleteOnboardingResponse{Message: "Onboarding completed successfully", User: updated}, nil
+}
+
+// academicProfile validates the given columns of a requested profile and
+// returns them as a model; other columns are left zero
+func academicProfile(academic *pb.AcademicProfile, columns []string) (models.AcademicProfile, error) {
+	if academic == nil {
+		academic = &pb.AcademicProfile{}
+	}
+	var profile models.AcademicProfile
+	for _, column := range columns {
+		switch column {
+		case "target_year":
+			year := time.Now().Year()
+			if academic.TargetYear < int32(year) || academic.TargetYear > int32(year+maxTargetYearsAhead) {
+				return profile, apperr.InvalidField("academic.target_year", fmt.Sprintf("target_year must be between %d and %d", year, year+maxTargetYearsAhead))
+			}
+			profile.TargetYear = int(academic.TargetYear)
+		case "attempt":
+			if academic.Attempt < 1 || academic.Attempt > maxAttempts {
+				return profile, apperr.InvalidField("academic.attempt", fmt.Sprintf("attempt must be between 1 and %d", maxAttempts))
+			}
+			profile.Attempt = int(academic.Attempt)
+		case "state":
+			state := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(academic.State)), "IN-")
+			if _, ok := indianStates[state]; !ok {
+				return profile, apperr.InvalidField("academic.state", "state must be an ISO 3166-2:IN code such as \"MH\"")
+			}
+			profile.State = state
+		case "category":
+			if academic.Category == pb.ReservationCategory_RESERVATION_CATEGORY_UNSPECIFIED || pb.ReservationCategory_name[int32(academic.Category)] == "" {
+				return profile, apperr.InvalidField("academic.category", "category is required")
+			}
+			profile.Category = enumValue(academic.Category.String(), "RESERVATION_CATEGORY_")
+		case "pwbd":
+			profile.PwBD = academic.Pwbd
+		case "preferred_language":
+			if academic.PreferredLanguage == pb.Language_LANGUAGE_UNSPECIFIED || pb.Language_name[int32(academic.PreferredLanguage)] == "" {
+				return profile, apperr.InvalidField("academic.preferred_language", "preferred_language is required")
+			}
+			profile.PreferredLanguage = enumValue(academic.PreferredLanguage.String(), "LANGUAGE_")
+		case "coaching_institute":
+			institute := strings.TrimSpace(academic.CoachingInstitute)
+			if len(institute) > 255 {
+				return profile, apperr.InvalidField("academic.coaching_institute", "coaching_institute is too long (max 255 characters)")
+			}
+			profile.CoachingInstitute = institute
+		case "weak_subjects", "strong_subjects":
+			subjects := academic.WeakSubjects
+			if column == "strong_subjects" {
+				subjects = academic.StrongSubjects
+			}
+			names, err := subjectNames(column, subjects)
+			if err != nil {
+				return profile, err
+			}
+			if column == "weak_subjects" {
+				profile.WeakSubjects = names
+			} else {
+				profile.StrongSubjects = names
+			}
+		case "daily_study_minutes":
+			if academic.DailyStudyMinutes < 1 || academic.DailyStudyMinutes > maxDailyStudyMinutes {
+				return profile, apperr.InvalidField("academic.daily_study_minutes", fmt.Sprintf("daily_study_minutes must be between 1 and %d", maxDailyStudyMinutes))
+			}
+			profile.DailyStudyMinutes = int(academic.DailyStudyMinutes)
+		}
+	}
+	return profile, nil
+}
+
+func subjectNames(field string, subjects []pb.Subject) ([]string, error) {
+	names := []string{}
+	for _, subject := range subjects {
+		if subject == pb.Subject_SUBJECT_UNSPECIFIED || pb.Subject_name[int32(subject)] == "" {
+			return nil, apperr.InvalidField("academic."+field, field+" contains an unknown subject")
+		}
+		name := enumValue(subject.String(), "SUBJECT_")
+		if slices.Contains(names, name) {
+			return nil, apperr.InvalidField("academic."+field, fmt.Sprintf("%s lists %s twice", field, name))
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// checkSubjects rejects a subject that is both weak and strong
+func checkSubjects(weak, strong []string) error {
+	for _, subject := range weak {
+		if slices.Contains(strong, subject) {
+			return apperr.InvalidField("academic.strong_subjects", fmt.Sprintf("%s can't be both a weak and a strong subject", subject))
+		}
+	}
+	return nil
+}
+
+func academicSummary(profile models.AcademicProfile) *pb.AcademicProfile {
+	summary := &pb.AcademicProfile{
+		TargetYear:        int32(profile.TargetYear),
+		Attempt:           int32(profile.Attempt),
+		State:             profile.State,
+		Category:          pb.ReservationCategory(pb.ReservationCategory_value[enumName(profile.Category, "RESERVATION_CATEGORY_")]),
+		Pwbd:              profile.PwBD,
+		PreferredLanguage: pb.Language(pb.Language_value[enumName(profile.PreferredLanguage, "LANGUAGE_")]),
+		CoachingInstitute: profile.CoachingInstitute,
+		DailyStudyMinutes: int32(profile.DailyStudyMinutes),
+		OnboardedAt:       timestamppb.New(profile.OnboardedAt),
+	}
+	for _, subject := range profile.WeakSubjects {
+		summary.WeakSubjects = append(summary.WeakSubjects, pb.Subject(pb.Subject_value[enumName(subject, "SUBJECT_")]))
+	}
+	for _, subject := range profile.StrongSubjects {
+		summary.StrongSubjects = append(summary.StrongSubjects, pb.Subject(pb.Subject_value[enumName(subject, "SUBJECT_")]))
+	}
+	return summary
+}
+
+// enumValue is how an enum is stored: "RESERVATION_CATEGORY_OBC_NCL" becomes "obc_ncl"
+func enumValue(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+// enumName reverses enumValue
+func enumName(value, prefix string) string {
+	return prefix + strings.ToUpper(value)
+}
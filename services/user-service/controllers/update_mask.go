@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/user"
+)
+
+// updatableFields are the leaf UpdateUserRequest paths an update_mask may select.
+// Profile paths are named after their columns.
+var updatableFields = []string{
+	"name",
+	"email",
+	"role",
+	"admin.permissions",
+	"mentor.bio",
+	"academic.target_year",
+	"academic.attempt",
+	"academic.state",
+	"academic.category",
+	"academic.pwbd",
+	"academic.preferred_language",
+	"academic.coaching_institute",
+	"academic.weak_subjects",
+	"academic.strong_subjects",
+	"academic.daily_study_minutes",
+}
+
+// outputOnlyFields are derived from cohort membership and can't be set
+var outputOnlyFields = map[string]bool{
+	"admin.managed_users":  true,
+	"mentor.cohorts":       true,
+	"mentor.managed_users": true,
+}
+
+// fieldSet holds the leaf paths an update touches
+type fieldSet map[string]bool
+
+// updateFields expands the request's update_mask into leaf paths: "admin"
+// selects every admin field and "*" every field. Without a mask, the fields
+// that are set are updated, so clients written before masks keep working.
+func updateFields(req *pb.UpdateUserRequest) (fieldSet, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.Name != "" {
+			paths = append(paths, "name")
+		}
+		if req.Email != "" {
+			paths = append(paths, "email")
+		}
+		if req.Role != "" {
+			paths = append(paths, "role")
+		}
+		if req.GetMentee() != nil {
+			paths = append(paths, "mentee")
+		}
+		if req.GetAdmin() != nil {
+			paths = append(paths, "admin")
+		}
+		if req.GetMentor() != nil {
+			paths = append(paths, "mentor")
+		}
+		if req.GetAcademic() != nil {
+			paths = append(paths, "academic")
+		}
+	}
+
+	fields := fieldSet{}
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if outputOnlyFields[path] {
+			return nil, apperr.InvalidField("update_mask", path+" is output only")
+		}
+		if path == "mentee" || strings.HasPrefix(path, "mentee.") {
+			return nil, apperr.InvalidField("update_mask", "mentee progress is server-owned; use RecordActivity")
+		}
+		matched := false
+		for _, field := range updatableFields {
+			if path == field || strings.HasPrefix(field, path+".") || path == "*" && wildcardIncludes(req, field) {
+				fields[field] = true
+				matched = true
+			}
+		}
+		if !matched && path != "*" {
+			return nil, apperr.InvalidField("update_mask", fmt.Sprintf("unknown update_mask path %q", path))
+		}
+	}
+	if len(fields) == 0 {
+		return nil, apperr.InvalidField("update_mask", "nothing to update")
+	}
+	return fields, nil
+}
+
+// wildcardIncludes keeps "*" from touching the profile the request didn't send
+func wildcardIncludes(req *pb.UpdateUserRequest, field string) bool {
+	switch {
+	case strings.HasPrefix(field, "admin."):
+		return req.GetAdmin() != nil
+	case strings.HasPrefix(field, "mentor."):
+		return req.GetMentor() != nil
+	case strings.HasPrefix(field, "academic."):
+		return req.GetAcademic() != nil
+	}
+	return true
+}
+
+// columns lists the selected fields under a profile, without the prefix
+func (f fieldSet) columns(profile string) []string {
+	var columns []string
+	for _, field := range updatableFields {
+		if f[field] {
+			if column, ok := strings.CutPrefix(field, profile+"."); ok {
+				columns = append(columns, column)
+			}
+		}
+	}
+	return columns
+}
+
+// userETag is the opaque etag clients send back to guard against stale writes
+func userETag(version uint64) string {
+	return strconv.FormatUint(version, 10)
+}
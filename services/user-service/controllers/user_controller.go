@@ -4,21 +4,105 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"strings"
+	"time"
 
-	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/audit"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/outbox"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	authzpb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/authz"
+	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/user"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/database"
 	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/progress"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
 type UserController struct {
 	pb.UnimplementedUserServiceServer
-	DB *gorm.DB
+	DB             *gorm.DB
+	RestoreWindow  time.Duration  // How long deleted users can be restored before they are erased
+	Progress       progress.Rules // XP awards, level curve and streak days for RecordActivity
+	Media          storage.Store  // Where avatars are stored; nil disables uploads
+	AvatarMaxBytes int64          // Largest image UploadAvatar accepts
+}
+
+// CreateUser creates an account (without a password) and its profile
+func (c *UserController) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	email := strings.TrimSpace(req.Email)
+	if name == "" || !strings.Contains(email, "@") {
+		return nil, apperr.InvalidArgument(apperr.ReasonInvalidField, "name and a valid email are required").
+			WithField("name", "name is required").
+			WithField("email", "a valid email is required")
+	}
+
+	role := authzpb.Role_ROLE_STUDENT
+	if req.Role != "" {
+		role = authz.ParseRole(req.Role)
+		if role == authzpb.Role_ROLE_UNSPECIFIED {
+			return nil, apperr.InvalidField("role", "invalid role")
+		}
+	}
+	if role != authzpb.Role_ROLE_STUDENT && !authz.HasPermission(ctx, authzpb.Permission_PERMISSION_ROLES_MANAGE) {
+		return nil, apperr.PermissionDenied("creating non-student users requires PERMISSION_ROLES_MANAGE")
+	}
+
+	var existing int64
+	if err := c.DB.Unscoped().Model(&models.User{}).Where("LOWER(email) = LOWER(?)", email).Count(&existing).Error; err != nil {
+		return nil, errDatabase
+	}
+	if existing > 0 {
+		return nil, errEmailTaken
+	}
+
+	user := models.User{Name: name, Email: email, Role: authz.RoleName(role)}
+	err := c.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+		return database.EnsureProfile(tx, strconv.FormatUint(uint64(user.ID), 10), role)
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to create user")
+	}
+
+	audit.Record(ctx, c.DB, audit.Event{
+		Type:     audit.UserRegistered,
+		TargetID: strconv.FormatUint(uint64(user.ID), 10),
+		Details:  map[string]interface{}{"role": user.Role, "created_by_admin": true},
+	})
+
+	return &pb.CreateUserResponse{User: userSummary(user), Message: "User created successfully"}, nil
 }
 
 func (c *UserController) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	return c.loadUser(targetUser(ctx, req.UserId))
+}
+
+// targetUser resolves the user_id of a request, where empty means the caller
+// (as self_field access rules already treat it)
+func targetUser(ctx context.Context, userID string) string {
+	if userID == "" {
+		return authz.UserID(ctx)
+	}
+	return userID
+}
+
+// loadUser reads a user with their role-specific profile
+func (c *UserController) loadUser(userID string) (*pb.GetUserResponse, error) {
+	db := c.DB
 	var user models.User
-	if err := c.DB.First(&user, "id = ?", req.UserId).Error; err != nil {
-		return nil, errors.New("user not found")
+	if err := db.First(&user, "id = ?", userID).Error; err != nil {
+		return nil, errUserNotFound
 	}
 
 	userIDStr := strconv.FormatUint(uint64(user.ID), 10)
@@ -28,31 +112,49 @@ func (c *UserController) GetUser(ctx context.Context, req *pb.GetUserRequest) (*
 		Name:   user.Name,
 		Email:  user.Email,
 		Role:   user.Role,
+		Etag:   userETag(user.Version),
+	}
+
+	var avatar models.Avatar
+	if err := db.First(&avatar, "user_id = ?", userIDStr).Error; err == nil {
+		response.Avatar = c.avatarSummary(avatar)
+	}
+	var academic models.AcademicProfile
+	if err := db.First(&academic, "user_id = ?", userIDStr).Error; err == nil {
+		response.Academic = academicSummary(academic)
 	}
 
 	// Fetch Mentee or Admin details
-	if user.Role == "mentee" {
+	switch authz.ParseRole(user.Role) {
+	case authzpb.Role_ROLE_STUDENT:
 		var mentee models.Mentee
-		if err := c.DB.First(&mentee, "user_id = ?", req.UserId).Error; err == nil {
-			response.UserDetails = &pb.GetUserResponse_Mentee{
-				Mentee: &pb.Mentee{
-					Experience:     int32(mentee.Experience),
-					Level:          int32(mentee.Level),
-					CurrentStreak:  int32(mentee.CurrentStreak),
-					LongestStreak:  int32(mentee.LongestStreak),
-					QuizzesPlayed:  int32(mentee.QuizzesPlayed),
-					CorrectAnswers: int32(mentee.CorrectAnswers),
-					Badges:         mentee.Badges,
-				},
-			}
+		if err := db.First(&mentee, "user_id = ?", userIDStr).Error; err == nil {
+			badges, _ := database.UserBadges(db, []string{userIDStr})
+			response.UserDetails = &pb.GetUserResponse_Mentee{Mentee: c.menteeSummary(mentee, badges[userIDStr])}
 		}
-	} else if user.Role == "admin" {
+	case authzpb.Role_ROLE_ADMIN:
 		var admin models.Admin
-		if err := c.DB.First(&admin, "user_id = ?", req.UserId).Error; err == nil {
+		if err := db.First(&admin, "user_id = ?", userIDStr).Error; err == nil {
+			permissions, _ := database.UserGrants(db, userIDStr)
+			managed, _ := database.ManagedUsers(db, userIDStr)
 			response.UserDetails = &pb.GetUserResponse_Admin{
 				Admin: &pb.Admin{
-					Permissions:  admin.Permissions,
-					ManagedUsers: int32(admin.ManagedUsers),
+					Permissions:  permissions,
+					ManagedUsers: int32(managed),
+				},
+			}
+		}
+	case authzpb.Role_ROLE_MENTOR:
+		var mentor models.Mentor
+		if err := db.First(&mentor, "user_id = ?", userIDStr).Error; err == nil {
+			var cohorts int64
+			db.Model(&models.Cohort{}).Where("mentor_id = ?", userIDStr).Count(&cohorts)
+			managed, _ := database.ManagedUsers(db, userIDStr)
+			response.UserDetails = &pb.GetUserResponse_Mentor{
+				Mentor: &pb.Mentor{
+					Bio:          mentor.Bio,
+					Cohorts:      int32(cohorts),
+					ManagedUsers: int32(managed),
 				},
 			}
 		}
@@ -61,50 +163,344 @@ func (c *UserController) GetUser(ctx context.Context, req *pb.GetUserRequest) (*
 	return response, nil
 }
 
-// UpdateUser handles updating both mentee & admin data
+// ListUsers pages through users by ID, optionally filtered by role and a name/email search
+func (c *UserController) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	query := c.DB.Model(&models.User{}).Select("id, name, email, role, email_verified, created_at")
+	if req.Role != "" {
+		role := authz.ParseRole(req.Role)
+		if role == authzpb.Role_ROLE_UNSPECIFIED {
+			return nil, apperr.InvalidField("role", "invalid role")
+		}
+		query = query.Where("role = ?", authz.RoleName(role))
+	}
+	if search := strings.TrimSpace(req.Query); search != "" {
+		pattern := "%" + escapeLike(search) + "%"
+		query = query.Where("name ILIKE ? OR email ILIKE ?", pattern, pattern)
+	}
+	// The page token is the last user ID of the previous page
+	if req.PageToken != "" {
+		cursor, err := strconv.ParseUint(req.PageToken, 10, 64)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		query = query.Where("id > ?", cursor)
+	}
+
+	var users []models.User
+	if err := query.Order("id").Limit(pageSize + 1).Find(&users).Error; err != nil {
+		return nil, apperr.Internal("failed to list users")
+	}
+
+	response := &pb.ListUsersResponse{}
+	if len(users) > pageSize {
+		users = users[:pageSize]
+		response.NextPageToken = strconv.FormatUint(uint64(users[pageSize-1].ID), 10)
+	}
+	response.Users = make([]*pb.User, 0, len(users))
+	for _, user := range users {
+		response.Users = append(response.Users, userSummary(user))
+	}
+	return response, nil
+}
+
+// GetUsersByIds looks up many users in one query
+func (c *UserController) GetUsersByIds(ctx context.Context, req *pb.GetUsersByIdsRequest) (*pb.GetUsersByIdsResponse, error) {
+	if len(req.UserIds) > maxPageSize {
+		return nil, apperr.InvalidField("user_ids", "too many user IDs (max 200)")
+	}
+
+	ids := make([]uint64, 0, len(req.UserIds))
+	for _, raw := range req.UserIds {
+		if id, err := strconv.ParseUint(raw, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return &pb.GetUsersByIdsResponse{}, nil
+	}
+
+	var users []models.User
+	if err := c.DB.Select("id, name, email, role, email_verified, created_at").Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, apperr.Internal("failed to fetch users")
+	}
+	byID := make(map[uint64]models.User, len(users))
+	for _, user := range users {
+		byID[uint64(user.ID)] = user
+	}
+
+	response := &pb.GetUsersByIdsResponse{Users: make([]*pb.User, 0, len(users))}
+	for _, id := range ids {
+		if user, ok := byID[id]; ok {
+			response.Users = append(response.Users, userSummary(user))
+			delete(byID, id) // Duplicate IDs are returned once
+		}
+	}
+	return response, nil
+}
+
+// UpdateUser applies the fields in update_mask to the user and their profile
+// in one transaction, rejecting the write if the etag is stale
 func (c *UserController) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	fields, err := updateFields(req)
+	if err != nil {
+		return nil, err
+	}
+
 	var user models.User
-	if err := c.DB.First(&user, "id = ?", req.UserId).Error; err != nil {
-		return nil, errors.New("user not found")
+	if err := c.DB.First(&user, "id = ?", targetUser(ctx, req.UserId)).Error; err != nil {
+		return nil, errUserNotFound
+	}
+	if req.Etag != "" && req.Etag != userETag(user.Version) {
+		return nil, errStaleETag
 	}
+	userID := strconv.FormatUint(uint64(user.ID), 10)
 
-	user.Name = req.Name
-	user.Email = req.Email
-	user.Role = req.Role
-	c.DB.Save(&user)
+	updates := map[string]interface{}{}
+	if fields["name"] {
+		name := strings.TrimSpace(req.Name)
+		if name == "" {
+			return nil, apperr.InvalidField("name", "name cannot be empty")
+		}
+		updates["name"] = name
+	}
+	if fields["email"] {
+		email := strings.TrimSpace(req.Email)
+		if !strings.Contains(email, "@") {
+			return nil, apperr.InvalidField("email", "a valid email is required")
+		}
+		if !strings.EqualFold(email, user.Email) {
+			var existing int64
+			if err := c.DB.Unscoped().Model(&models.User{}).Where("LOWER(email) = LOWER(?) AND id <> ?", email, user.ID).Count(&existing).Error; err != nil {
+				return nil, errDatabase
+			}
+			if existing > 0 {
+				return nil, errEmailTaken
+			}
+			updates["email_verified"] = false // The new address has to be verified again
+		}
+		updates["email"] = email
+	}
 
-	// Update Mentee
-	if req.GetMentee() != nil {
-		var mentee models.Mentee
-		if err := c.DB.First(&mentee, "user_id = ?", req.UserId).Error; err == nil {
-			mentee.Experience = int(req.GetMentee().Experience)
-			mentee.Level = int(req.GetMentee().Level)
-			mentee.CurrentStreak = int(req.GetMentee().CurrentStreak)
-			mentee.LongestStreak = int(req.GetMentee().LongestStreak)
-			mentee.QuizzesPlayed = int(req.GetMentee().QuizzesPlayed)
-			mentee.CorrectAnswers = int(req.GetMentee().CorrectAnswers)
-			mentee.Badges = req.GetMentee().Badges
-			c.DB.Save(&mentee)
+	// Only callers allowed to manage roles may change one, and only to a known role
+	role := authz.ParseRole(user.Role)
+	if fields["role"] {
+		role = authz.ParseRole(req.Role)
+		if role == authzpb.Role_ROLE_UNSPECIFIED {
+			return nil, apperr.InvalidField("role", "invalid role")
+		}
+		if authz.RoleName(role) != user.Role {
+			if !authz.HasPermission(ctx, authzpb.Permission_PERMISSION_ROLES_MANAGE) {
+				return nil, apperr.PermissionDenied("changing roles requires PERMISSION_ROLES_MANAGE")
+			}
+			updates["role"] = authz.RoleName(role)
 		}
 	}
 
-	// Update Admin
-	if req.GetAdmin() != nil {
-		var admin models.Admin
-		if err := c.DB.First(&admin, "user_id = ?", req.UserId).Error; err == nil {
-			admin.Permissions = req.GetAdmin().Permissions
-			admin.ManagedUsers = int(req.GetAdmin().ManagedUsers)
-			c.DB.Save(&admin)
+	if fields["admin.permissions"] {
+		if role != authzpb.Role_ROLE_ADMIN {
+			return nil, wrongRole("admin fields only apply to admins")
+		}
+		if !authz.HasPermission(ctx, authzpb.Permission_PERMISSION_ROLES_MANAGE) {
+			return nil, apperr.PermissionDenied("managing permission grants requires PERMISSION_ROLES_MANAGE")
+		}
+	}
+	if fields["mentor.bio"] && role != authzpb.Role_ROLE_MENTOR {
+		return nil, wrongRole("mentor fields only apply to mentors")
+	}
+
+	academicColumns := fields.columns("academic")
+	var academic models.AcademicProfile
+	if len(academicColumns) > 0 {
+		if role != authzpb.Role_ROLE_STUDENT {
+			return nil, wrongRole("academic fields only apply to students")
+		}
+		var current models.AcademicProfile
+		if err := c.DB.First(&current, "user_id = ?", userID).Error; err != nil {
+			return nil, errOnboardingNeeded
+		}
+		if academic, err = academicProfile(req.GetAcademic(), academicColumns); err != nil {
+			return nil, err
+		}
+		weak, strong := current.WeakSubjects, current.StrongSubjects
+		if fields["academic.weak_subjects"] {
+			weak = academic.WeakSubjects
+		}
+		if fields["academic.strong_subjects"] {
+			strong = academic.StrongSubjects
+		}
+		if err := checkSubjects(weak, strong); err != nil {
+			return nil, err
 		}
 	}
 
-	return &pb.UpdateUserResponse{Message: "User updated successfully"}, nil
+	err = c.DB.Transaction(func(tx *gorm.DB) error {
+		// Compare-and-swap on the version the checks above were made against
+		updates["version"] = gorm.Expr("version + 1")
+		result := tx.Model(&models.User{}).Where("id = ? AND version = ?", user.ID, user.Version).Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errStaleETag
+		}
+
+		// auth-service caches credentials by email, with the role
+		newEmail, _ := updates["email"].(string)
+		if _, roleChanged := updates["role"]; roleChanged || (newEmail != "" && newEmail != user.Email) {
+			event := outbox.UserUpdated{UserID: userID, Email: user.Email, Role: authz.RoleName(role)}
+			if newEmail != user.Email {
+				event.NewEmail = newEmail
+			}
+			if err := outbox.Publish(tx, outbox.TopicUserUpdated, userID, event); err != nil {
+				return err
+			}
+		}
+
+		// A role change needs the new role's profile to exist
+		if err := database.EnsureProfile(tx, userID, role); err != nil {
+			return err
+		}
+		if len(academicColumns) > 0 {
+			err := tx.Model(&models.AcademicProfile{}).Where("user_id = ?", userID).
+				Select(append(academicColumns, "updated_at")).Updates(&academic).Error
+			if err != nil {
+				return err
+			}
+		}
+		switch role {
+		case authzpb.Role_ROLE_ADMIN:
+			if fields["admin.permissions"] {
+				return database.ReplaceGrants(tx, user.ID, req.GetAdmin().GetPermissions(), authz.UserID(ctx))
+			}
+		case authzpb.Role_ROLE_MENTOR:
+			if fields["mentor.bio"] {
+				return tx.Model(&models.Mentor{}).Where("user_id = ?", userID).Update("bio", req.GetMentor().GetBio()).Error
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errStaleETag) {
+		return nil, errStaleETag
+	}
+	if err != nil {
+		return nil, apperr.Internal("failed to update user")
+	}
+
+	if newRole, ok := updates["role"]; ok {
+		audit.Record(ctx, c.DB, audit.Event{
+			Type:     audit.RoleChanged,
+			TargetID: userID,
+			Details:  map[string]interface{}{"from": user.Role, "to": newRole},
+		})
+	}
+	if fields["admin.permissions"] {
+		permissions := make([]string, 0, len(req.GetAdmin().GetPermissions()))
+		for _, permission := range req.GetAdmin().GetPermissions() {
+			permissions = append(permissions, permission.String())
+		}
+		audit.Record(ctx, c.DB, audit.Event{
+			Type:     audit.PermissionsChanged,
+			TargetID: userID,
+			Details:  map[string]interface{}{"permissions": permissions},
+		})
+	}
+
+	updated, err := c.loadUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateUserResponse{Message: "User updated successfully", User: updated}, nil
 }
 
-// DeleteUser handles deleting both mentee & admin
+// DeleteUser soft-deletes a user: they are signed out everywhere and can be
+// restored until the purge job erases them after RestoreWindow
 func (c *UserController) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
-	if err := c.DB.Delete(&models.User{}, "id = ?", req.UserId).Error; err != nil {
-		return nil, errors.New("failed to delete user")
+	var user models.User
+	if err := c.DB.Select("id, email").First(&user, "id = ?", req.UserId).Error; err != nil {
+		return nil, errUserNotFound
+	}
+	userID := strconv.FormatUint(uint64(user.ID), 10)
+
+	deletedAt := time.Now()
+	err := c.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&user).Error; err != nil {
+			return err
+		}
+		// auth-service revokes the user's sessions
+		return outbox.Publish(tx, outbox.TopicUserDeleted, userID, outbox.UserDeleted{UserID: userID, Email: user.Email})
+	})
+	if err != nil {
+		return nil, apperr.Internal("failed to delete user")
 	}
-	return &pb.DeleteUserResponse{Message: "User deleted successfully"}, nil
+	audit.Record(ctx, c.DB, audit.Event{Type: audit.UserDeleted, TargetID: userID})
+
+	return &pb.DeleteUserResponse{
+		Message:    "User deleted successfully",
+		PurgeAfter: timestamppb.New(deletedAt.Add(c.RestoreWindow)),
+	}, nil
+}
+
+// RestoreUser undoes DeleteUser while the restore window is open
+func (c *UserController) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error) {
+	result := c.DB.Unscoped().Model(&models.User{}).
+		Where("id = ? AND deleted_at IS NOT NULL AND deleted_at > ?", req.UserId, time.Now().Add(-c.RestoreWindow)).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return nil, apperr.Internal("failed to restore user")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errNothingToRestore
+	}
+	audit.Record(ctx, c.DB, audit.Event{Type: audit.UserRestored, TargetID: req.UserId})
+	return &pb.RestoreUserResponse{Message: "User restored successfully"}, nil
+}
+
+func userSummary(user models.User) *pb.User {
+	return &pb.User{
+		UserId:        strconv.FormatUint(uint64(user.ID), 10),
+		Name:          user.Name,
+		Email:         user.Email,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerified,
+		CreatedAt:     timestamppb.New(user.CreatedAt),
+	}
+}
+
+func (c *UserController) menteeSummary(mentee models.Mentee, badges []models.UserBadge) *pb.Mentee {
+	summary := &pb.Mentee{
+		Experience:          int32(mentee.Experience),
+		Level:               int32(mentee.Level),
+		CurrentStreak:       int32(c.Progress.CurrentStreak(mentee.CurrentStreak, mentee.LastActiveOn, time.Now())),
+		LongestStreak:       int32(mentee.LongestStreak),
+		QuizzesPlayed:       int32(mentee.QuizzesPlayed),
+		CorrectAnswers:      int32(mentee.CorrectAnswers),
+		NextLevelExperience: int32(c.Progress.Curve.NextLevelAt(mentee.Level)),
+		Badges:              make([]*pb.Badge, 0, len(badges)),
+	}
+	for _, badge := range badges {
+		summary.Badges = append(summary.Badges, badgeSummary(badge))
+	}
+	return summary
+}
+
+func badgeSummary(badge models.UserBadge) *pb.Badge {
+	return &pb.Badge{
+		BadgeId:     badge.BadgeID,
+		Name:        badge.Badge.Name,
+		Description: badge.Badge.Description,
+		EarnedAt:    timestamppb.New(badge.EarnedAt),
+	}
+}
+
+// escapeLike makes user input match literally inside a LIKE pattern
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
 }
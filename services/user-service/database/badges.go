@@ -0,0 +1,58 @@
+package database
+
+import (
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserBadges loads the earned badges of users, oldest first, keyed by user ID
+func UserBadges(db *gorm.DB, userIDs []string) (map[string][]models.UserBadge, error) {
+	var badges []models.UserBadge
+	err := db.Preload("Badge").Where("user_id IN ?", userIDs).Order("earned_at, badge_id").Find(&badges).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := make(map[string][]models.UserBadge, len(userIDs))
+	for _, badge := range badges {
+		byUser[badge.UserID] = append(byUser[badge.UserID], badge)
+	}
+	return byUser, nil
+}
+
+// AwardBadges gives a mentee every badge whose metric threshold they reached
+// and returns the newly earned ones
+func AwardBadges(tx *gorm.DB, mentee models.Mentee, earnedAt time.Time) ([]models.UserBadge, error) {
+	var definitions []models.BadgeDefinition
+	if err := tx.Where("metric IN ?", models.BadgeMetrics).Order("threshold, id").Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+
+	metrics := map[string]int{
+		"experience":      mentee.Experience,
+		"level":           mentee.Level,
+		"current_streak":  mentee.CurrentStreak,
+		"longest_streak":  mentee.LongestStreak,
+		"quizzes_played":  mentee.QuizzesPlayed,
+		"correct_answers": mentee.CorrectAnswers,
+	}
+	var earned []models.UserBadge
+	for _, definition := range definitions {
+		if metrics[definition.Metric] < definition.Threshold {
+			continue
+		}
+		badge := models.UserBadge{UserID: mentee.UserID, BadgeID: definition.ID, EarnedAt: earnedAt}
+		result := tx.Omit("Badge").Clauses(clause.OnConflict{DoNothing: true}).Create(&badge)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected > 0 {
+			badge.Badge = definition
+			earned = append(earned, badge)
+		}
+	}
+	return earned, nil
+}
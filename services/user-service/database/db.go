@@ -5,6 +5,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/outbox"
 	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -28,7 +29,12 @@ func ConnectDatabase() {
 	sqlDB.SetMaxIdleConns(100)
 	sqlDB.SetConnMaxLifetime(time.Minute * 5)
 
-	database.AutoMigrate(&models.Mentee{}, &models.Admin{})
+	if err := models.Migrate(database); err != nil {
+		log.Fatal("❌ Failed to migrate database:", err)
+	}
+	if err := outbox.Migrate(database); err != nil {
+		log.Fatal("❌ Failed to migrate outbox:", err)
+	}
 
 	DB = database
 	log.Println("✅ Database connected successfully!")
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	authzpb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/authz"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"gorm.io/gorm"
+)
+
+// GrantStore reads per-user permission grants for the authz enforcer
+type GrantStore struct {
+	DB *gorm.DB
+}
+
+// Grants implements authz.GrantStore
+func (s *GrantStore) Grants(ctx context.Context, userID string) ([]authzpb.Permission, error) {
+	return UserGrants(s.DB.WithContext(ctx), userID)
+}
+
+// UserGrants lists the permissions granted to a user
+func UserGrants(db *gorm.DB, userID string) ([]authzpb.Permission, error) {
+	var grants []models.PermissionGrant
+	if err := db.Where("user_id = ?", userID).Order("permission").Find(&grants).Error; err != nil {
+		return nil, err
+	}
+
+	permissions := make([]authzpb.Permission, 0, len(grants))
+	for _, grant := range grants {
+		if permission := authz.ParsePermission(grant.Permission); permission != authzpb.Permission_PERMISSION_UNSPECIFIED {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions, nil
+}
+
+// ReplaceGrants sets a user's grants to exactly the given permissions
+func ReplaceGrants(tx *gorm.DB, userID uint, permissions []authzpb.Permission, grantedBy string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&models.PermissionGrant{}).Error; err != nil {
+		return err
+	}
+	for _, permission := range permissions {
+		if permission == authzpb.Permission_PERMISSION_UNSPECIFIED {
+			continue
+		}
+		grant := models.PermissionGrant{UserID: userID, Permission: permission.String(), GrantedBy: grantedBy}
+		if err := tx.Where(models.PermissionGrant{UserID: userID, Permission: grant.Permission}).FirstOrCreate(&grant).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package database
+
+import (
+	authzpb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/authz"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// EnsureProfile creates the role-specific profile of a user unless it exists
+func EnsureProfile(tx *gorm.DB, userID string, role authzpb.Role) error {
+	var profile interface{}
+	switch role {
+	case authzpb.Role_ROLE_STUDENT:
+		profile = &models.Mentee{UserID: userID}
+	case authzpb.Role_ROLE_ADMIN:
+		profile = &models.Admin{UserID: userID}
+	case authzpb.Role_ROLE_MENTOR:
+		profile = &models.Mentor{UserID: userID}
+	default:
+		return nil // Other roles have no profile
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(profile).Error
+}
+
+// ManagedUsers counts the students in the cohorts a user owns
+func ManagedUsers(db *gorm.DB, userID string) (int64, error) {
+	var count int64
+	err := db.Model(&models.CohortMember{}).
+		Joins("JOIN cohorts ON cohorts.id = cohort_members.cohort_id").
+		Where("cohorts.mentor_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,25 @@
+package database
+
+import (
+	"context"
+
+	authModels "github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"gorm.io/gorm"
+)
+
+// SessionStore reads auth-service's sessions table for authz.WithSessionCheck
+type SessionStore struct {
+	DB *gorm.DB
+}
+
+// SessionRevoked implements authz.SessionStore; unknown sessions count as revoked
+func (s *SessionStore) SessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	var count int64
+	err := s.DB.WithContext(ctx).Model(&authModels.Session{}).
+		Where("id = ? AND revoked_at IS NULL", sessionID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count == 0, nil
+}
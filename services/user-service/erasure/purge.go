@@ -0,0 +1,117 @@
+// Package erasure permanently removes users whose restore window has ended
+package erasure
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/audit"
+	authModels "github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/media"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/storage"
+	"gorm.io/gorm"
+)
+
+// Purger erases soft-deleted users once Window has passed: their profile,
+// grants and account (whose auth tables cascade) are deleted, pending outbox
+// messages about them are dropped, their avatar files are removed, and the
+// audit trail is anonymized.
+type Purger struct {
+	DB       *gorm.DB
+	Media    storage.Store // May be nil when avatar uploads are disabled
+	Window   time.Duration // Restore window after DeleteUser
+	Interval time.Duration // Time between runs
+}
+
+// Run purges on every Interval until ctx is cancelled
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+	for {
+		if purged, err := p.PurgeExpired(ctx); err != nil {
+			log.Println("⚠️ User purge failed:", err)
+		} else if purged > 0 {
+			log.Printf("✅ Erased %d deleted users", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpired erases every user deleted before the window, one transaction each
+func (p *Purger) PurgeExpired(ctx context.Context) (int, error) {
+	var users []models.User
+	err := p.DB.WithContext(ctx).Unscoped().
+		Select("id, email").
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", time.Now().Add(-p.Window)).
+		Find(&users).Error
+	if err != nil {
+		return 0, err
+	}
+
+	for i, user := range users {
+		var avatar models.Avatar
+		p.DB.WithContext(ctx).Select("prefix").Limit(1).Find(&avatar, "user_id = ?", strconv.FormatUint(uint64(user.ID), 10))
+		if err := p.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error { return erase(ctx, tx, user) }); err != nil {
+			return i, err
+		}
+		if avatar.Prefix != "" && p.Media != nil {
+			p.deleteAvatar(ctx, avatar.Prefix)
+		}
+	}
+	return len(users), nil
+}
+
+func erase(ctx context.Context, tx *gorm.DB, user models.User) error {
+	userID := strconv.FormatUint(uint64(user.ID), 10)
+
+	if err := audit.Anonymize(tx, userID, user.Email); err != nil {
+		return err
+	}
+	deletes := []struct {
+		model interface{}
+		query string
+		arg   interface{}
+	}{
+		{&models.Mentee{}, "user_id = ?", userID},
+		{&models.UserBadge{}, "user_id = ?", userID},
+		{&models.MenteeActivity{}, "user_id = ?", userID},
+		{&models.Admin{}, "user_id = ?", userID},
+		{&models.Mentor{}, "user_id = ?", userID},
+		{&models.Avatar{}, "user_id = ?", userID},
+		{&models.AcademicProfile{}, "user_id = ?", userID},
+		{&models.CohortMember{}, "user_id = ?", userID},
+		{&models.Cohort{}, "mentor_id = ?", userID}, // Frees their students to join another cohort
+		{&models.PermissionGrant{}, "user_id = ?", user.ID},
+		{&authModels.OutboxMessage{}, "key = ?", userID}, // Payloads carry the email and name
+	}
+	for _, d := range deletes {
+		if err := tx.Where(d.query, d.arg).Delete(d.model).Error; err != nil {
+			return err
+		}
+	}
+	// Sessions, tokens, 2FA and linked accounts cascade
+	if err := tx.Unscoped().Delete(&models.User{}, user.ID).Error; err != nil {
+		return err
+	}
+
+	audit.Record(ctx, tx, audit.Event{Type: audit.UserPurged, TargetID: userID})
+	return nil
+}
+
+// deleteAvatar removes avatar files once the user is gone; a failure only leaves
+// unreferenced files behind, so it doesn't fail the purge
+func (p *Purger) deleteAvatar(ctx context.Context, prefix string) {
+	for _, key := range media.AvatarKeys(prefix) {
+		if err := p.Media.Delete(ctx, key); err != nil {
+			log.Printf("⚠️ Failed to delete %s: %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Package events consumes the events other services publish through the outbox
+package events
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/outbox"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/models"
+	"gorm.io/gorm"
+)
+
+// Consumer is the name user-service's replicas share on the transport
+const Consumer = "user-service"
+
+// Subscribe registers user-service's handlers
+func Subscribe(transport outbox.Transport, db *gorm.DB) {
+	transport.Subscribe(Consumer, outbox.TopicUserRegistered, provisionProfile(db))
+}
+
+// provisionProfile creates the role-specific profile of a newly registered
+// user. Existing profiles are left alone, so redeliveries are harmless.
+func provisionProfile(db *gorm.DB) outbox.Handler {
+	return func(ctx context.Context, message outbox.Message) error {
+		var event outbox.UserRegistered
+		if err := message.Decode(&event); err != nil {
+			log.Printf("⚠️ Dropping malformed %s message %d: %v", message.Topic, message.ID, err)
+			return nil
+		}
+
+		// The current role wins over the event's, which may be outdated
+		var user models.User
+		err := db.WithContext(ctx).Select("id, role").First(&user, "id = ?", event.UserID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil // Deleted in the meantime
+		}
+		if err != nil {
+			return err
+		}
+		return database.EnsureProfile(db.WithContext(ctx), event.UserID, authz.ParseRole(user.Role))
+	}
+}
@@ -1,33 +1,157 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/Aditya-PS-05/NeetChamp/user-service/controllers"
 	"github.com/Aditya-PS-05/NeetChamp/user-service/database"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/erasure"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/events"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/progress"
+	"github.com/Aditya-PS-05/NeetChamp/user-service/storage"
 
-	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto"
+	"github.com/Aditya-PS-05/NeetChamp/auth-service/outbox"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/auth"
+	pb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/user"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
 	// Connect to the database
 	database.ConnectDatabase()
 
+	// Verify access tokens with the public keys published by auth-service
+	authAddr := os.Getenv("AUTH_SERVICE_ADDR")
+	if authAddr == "" {
+		authAddr = "localhost:50051"
+	}
+	authConn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to auth service: %v", err)
+	}
+	defer authConn.Close()
+	// Tokens of sessions revoked in auth-service (the shared sessions table) are rejected too
+	keySet := authz.NewRemoteKeySet(authServiceKeys(auth.NewAuthServiceClient(authConn)))
+	authInterceptor := authz.NewInterceptor(authz.WithSessionCheck(keySet, &database.SessionStore{DB: database.DB}))
+
 	// Start gRPC server
 	listener, err := net.Listen("tcp", ":50052")
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	userController := &controllers.UserController{DB: database.DB}
+	// Enforce the (authz.access) permissions declared in the proto, including per-user grants
+	rbac := authz.NewEnforcer(&database.GrantStore{DB: database.DB})
+
+	// Avatars go to the object store picked by MEDIA_STORE
+	mediaStore, err := storage.FromEnv()
+	if err != nil {
+		log.Fatalf("Failed to set up media storage: %v", err)
+	}
+	if local, ok := mediaStore.(*storage.LocalStore); ok {
+		mediaAddr := os.Getenv("MEDIA_HTTP_ADDR")
+		if mediaAddr == "" {
+			mediaAddr = ":8082"
+		}
+		go func() {
+			log.Printf("✅ Serving media from %s on %s", local.Dir, mediaAddr)
+			if err := http.ListenAndServe(mediaAddr, local.Handler()); err != nil {
+				log.Printf("⚠️ Media server stopped: %v", err)
+			}
+		}()
+	}
+	avatarMaxBytes := bytesFromEnv("AVATAR_MAX_BYTES", 5<<20)
+
+	grpcServer := grpc.NewServer(
+		// Leave room for an inline avatar next to the other fields
+		grpc.MaxRecvMsgSize(int(avatarMaxBytes)+1<<20),
+		grpc.ChainUnaryInterceptor(apperr.UnaryServerInterceptor(), authInterceptor.Unary(), rbac.Unary()),
+		grpc.ChainStreamInterceptor(apperr.StreamServerInterceptor(), authInterceptor.Stream(), rbac.Stream()),
+	)
+	restoreWindow := durationFromEnv("USER_RESTORE_WINDOW", 30*24*time.Hour)
+	userController := &controllers.UserController{
+		DB:             database.DB,
+		RestoreWindow:  restoreWindow,
+		Progress:       progress.RulesFromEnv(),
+		Media:          mediaStore,
+		AvatarMaxBytes: avatarMaxBytes,
+	}
 
 	pb.RegisterUserServiceServer(grpcServer, userController)
 
+	// Provision profiles for accounts registered through auth-service
+	transport := outbox.NewPoller(database.DB)
+	events.Subscribe(transport, database.DB)
+	go transport.Run(context.Background())
+
+	// Erase deleted users once they can no longer be restored
+	purger := &erasure.Purger{DB: database.DB, Media: mediaStore, Window: restoreWindow, Interval: time.Hour}
+	go purger.Run(context.Background())
+
 	log.Println("✅ User service is running on port 50052...")
 	if err := grpcServer.Serve(listener); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 }
+
+// authServiceKeys fetches the JWKS from auth-service over gRPC
+func authServiceKeys(client auth.AuthServiceClient) authz.KeySource {
+	return func(ctx context.Context) ([]authz.JSONWebKey, error) {
+		resp, err := client.GetJWKS(ctx, &auth.GetJWKSRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]authz.JSONWebKey, 0, len(resp.Keys))
+		for _, key := range resp.Keys {
+			keys = append(keys, authz.JSONWebKey{
+				Kty: key.Kty,
+				Kid: key.Kid,
+				Use: key.Use,
+				Alg: key.Alg,
+				N:   key.N,
+				E:   key.E,
+				Crv: key.Crv,
+				X:   key.X,
+			})
+		}
+		return keys, nil
+	}
+}
+
+// durationFromEnv parses a duration such as "720h", falling back when unset or invalid
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		log.Printf("⚠️ Invalid %s %q, using %s", key, value, fallback)
+		return fallback
+	}
+	return duration
+}
+
+// bytesFromEnv parses a positive byte count, falling back when unset or invalid
+func bytesFromEnv(key string, fallback int64) int64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n <= 0 {
+		log.Printf("⚠️ Invalid %s %q, using %d", key, value, fallback)
+		return fallback
+	}
+	return n
+}
@@ -0,0 +1,106 @@
+// Package media validates uploaded images and renders avatar sizes
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"net/http"
+
+	_ "image/gif"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// Images larger than this are rejected before they are decoded
+const maxPixels = 40_000_000
+
+var (
+	ErrUnsupportedType = errors.New("only JPEG, PNG, GIF and WebP images are supported")
+	ErrTooLarge        = errors.New("image dimensions are too large")
+	ErrInvalidImage    = errors.New("image could not be decoded")
+)
+
+// ContentTypes are the accepted image formats
+var ContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// Variant is one rendition of an avatar
+type Variant struct {
+	Name string // Object name, e.g. "large" for large.jpg
+	Size int    // Width and height in pixels
+}
+
+// AvatarVariants are rendered for every avatar, largest first
+var AvatarVariants = []Variant{
+	{Name: "large", Size: 512},
+	{Name: "medium", Size: 128},
+	{Name: "small", Size: 48},
+}
+
+// Decode checks the format from the data itself (not the declared type) and
+// the dimensions, then decodes the first frame
+func Decode(data []byte) (image.Image, error) {
+	if !ContentTypes[http.DetectContentType(data)] {
+		return nil, ErrUnsupportedType
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	return img, nil
+}
+
+// Square crops the center square of img and scales it to size. Transparency
+// is flattened onto white.
+func Square(img image.Image, size int) *image.RGBA {
+	bounds := img.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(
+		bounds.Min.X+(bounds.Dx()-side)/2,
+		bounds.Min.Y+(bounds.Dy()-side)/2,
+	))
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, crop, draw.Over, nil)
+	return dst
+}
+
+// EncodeJPEG re-encodes an image, which also drops metadata such as EXIF location
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// AvatarKey is the object key of one rendition under an avatar's prefix
+func AvatarKey(prefix string, variant Variant) string {
+	return prefix + "/" + variant.Name + ".jpg"
+}
+
+// AvatarKeys lists the object keys of every rendition under a prefix
+func AvatarKeys(prefix string) []string {
+	keys := make([]string, 0, len(AvatarVariants))
+	for _, variant := range AvatarVariants {
+		keys = append(keys, AvatarKey(prefix, variant))
+	}
+	return keys
+}
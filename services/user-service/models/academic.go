@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+// AcademicProfile holds a NEET aspirant's exam plans and study preferences,
+// captured at onboarding. Enums are stored by their lowercase names, e.g.
+// "obc_ncl" or "physics".
+type AcademicProfile struct {
+	UserID            string    `gorm:"primaryKey"`
+	TargetYear        int       `gorm:"not null"`
+	Attempt           int       `gorm:"not null"`
+	State             string    `gorm:"size:8;not null"` // ISO 3166-2:IN code, e.g. "MH"
+	Category          string    `gorm:"size:16;not null"`
+	PwBD              bool      `gorm:"column:pwbd;not null;default:false"`
+	PreferredLanguage string    `gorm:"size:16;not null"`
+	CoachingInstitute string    `gorm:"size:255"`
+	WeakSubjects      []string  `gorm:"serializer:json;type:jsonb"`
+	StrongSubjects    []string  `gorm:"serializer:json;type:jsonb"`
+	DailyStudyMinutes int       `gorm:"not null"`
+	OnboardedAt       time.Time `gorm:"not null"`
+	UpdatedAt         time.Time
+	User              User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+)
+
+// Avatar records where a user's current avatar renditions are stored. Each
+// upload gets a new Prefix, so old URLs never show the new image.
+type Avatar struct {
+	UserID    string `gorm:"primaryKey"`
+	Prefix    string `gorm:"size:255;not null"` // Object key prefix, e.g. "avatars/42/9f1c0b7e"
+	UpdatedAt time.Time
+	User      User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
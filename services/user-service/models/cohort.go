@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+// Mentor is the profile of a mentor, who owns cohorts of students
+type Mentor struct {
+	UserID string `gorm:"primaryKey"`
+	Bio    string `gorm:"type:text"`
+	User   User   `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// Cohort is a batch of students owned by a mentor (or an admin). Students
+// join it with the invite code.
+type Cohort struct {
+	ID         uint      `gorm:"primaryKey"`
+	Name       string    `gorm:"size:255;not null"`
+	MentorID   string    `gorm:"size:64;index;not null"` // User ID of the owner
+	InviteCode string    `gorm:"size:16;uniqueIndex;not null"`
+	CreatedAt  time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt  time.Time
+}
+
+// CohortMember puts a student in a cohort; a student is in at most one
+type CohortMember struct {
+	UserID   string    `gorm:"primaryKey"`
+	CohortID uint      `gorm:"index;not null"`
+	JoinedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	Cohort   Cohort    `gorm:"foreignKey:CohortID;constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BadgeDefinition describes a badge. Badges with a Metric are awarded by
+// RecordActivity once the mentee's metric reaches Threshold.
+type BadgeDefinition struct {
+	ID          string `gorm:"primaryKey;size:64"` // Slug, e.g. "streak-7"
+	Name        string `gorm:"size:128;not null"`
+	Description string `gorm:"type:text"`
+	Metric      string `gorm:"size:32"` // Mentee column such as "longest_streak"; empty if awarded by hand
+	Threshold   int
+	CreatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+// BadgeMetrics are the mentee columns a badge can be awarded on
+var BadgeMetrics = []string{"experience", "level", "current_streak", "longest_streak", "quizzes_played", "correct_answers"}
+
+// UserBadge records that a user earned a badge
+type UserBadge struct {
+	UserID   string          `gorm:"primaryKey"`
+	BadgeID  string          `gorm:"primaryKey;size:64"`
+	EarnedAt time.Time       `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	Badge    BadgeDefinition `gorm:"foreignKey:BadgeID;constraint:OnDelete:CASCADE"`
+}
+
+// MenteeActivity is one recorded quiz or practice session. Its ID is the
+// caller's idempotency key, so a retried RecordActivity is applied once.
+type MenteeActivity struct {
+	UserID            string `gorm:"primaryKey"`
+	ID                string `gorm:"primaryKey;size:128"`
+	QuestionsAnswered int
+	CorrectAnswers    int
+	QuizCompleted     bool
+	Experience        int       // XP awarded
+	OccurredAt        time.Time `gorm:"not null"`
+	CreatedAt         time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+// Badges every deployment starts with; edit the table to add more
+var defaultBadges = []BadgeDefinition{
+	{ID: "first-quiz", Name: "First Steps", Description: "Completed your first quiz", Metric: "quizzes_played", Threshold: 1},
+	{ID: "quizzes-50", Name: "Quiz Regular", Description: "Completed 50 quizzes", Metric: "quizzes_played", Threshold: 50},
+	{ID: "correct-100", Name: "Sharpshooter", Description: "Answered 100 questions correctly", Metric: "correct_answers", Threshold: 100},
+	{ID: "correct-1000", Name: "Marksman", Description: "Answered 1000 questions correctly", Metric: "correct_answers", Threshold: 1000},
+	{ID: "streak-7", Name: "On a Roll", Description: "Practised 7 days in a row", Metric: "longest_streak", Threshold: 7},
+	{ID: "streak-30", Name: "Unstoppable", Description: "Practised 30 days in a row", Metric: "longest_streak", Threshold: 30},
+	{ID: "level-10", Name: "Rising Star", Description: "Reached level 10", Metric: "level", Threshold: 10},
+}
+
+func seedBadges(db *gorm.DB) error {
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&defaultBadges).Error
+}
+
+// migrateLegacyBadges converts the old mentees.badges JSON array into
+// user_badges rows and drops the column. Unknown IDs become hand-awarded badges.
+func migrateLegacyBadges(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&Mentee{}, "badges") {
+		return nil
+	}
+
+	var rows []struct {
+		UserID string
+		Badges string
+	}
+	if err := db.Table("mentees").Select("user_id, badges").Where("badges IS NOT NULL AND badges <> ''").Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, row := range rows {
+			var ids []interface{}
+			if err := json.Unmarshal([]byte(row.Badges), &ids); err != nil {
+				continue
+			}
+			for _, raw := range ids {
+				id := fmt.Sprint(raw)
+				if id == "" || len(id) > 64 {
+					continue
+				}
+				definition := BadgeDefinition{ID: id, Name: id}
+				if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&definition).Error; err != nil {
+					return err
+				}
+				if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&UserBadge{UserID: row.UserID, BadgeID: id, EarnedAt: time.Now()}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Migrator().DropColumn(&Mentee{}, "badges")
+	})
+}
@@ -1,7 +1,13 @@
 package models
 
 import (
+	"encoding/json"
+	"strconv"
+	"time"
+
 	authModels "github.com/Aditya-PS-05/NeetChamp/auth-service/models"
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/authz"
+	authzpb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/authz"
 	"gorm.io/gorm"
 )
 
@@ -9,24 +15,101 @@ import (
 type User = authModels.User
 
 type Mentee struct {
-	UserID         string `gorm:"primaryKey"`
-	Experience     int    `gorm:"default:0"` // XP points
-	Level          int    `gorm:"default:1"` // User's level
-	CurrentStreak  int    `gorm:"default:0"` // Consecutive active days
-	LongestStreak  int    `gorm:"default:0"` // Highest streak ever
-	QuizzesPlayed  int    `gorm:"default:0"` // Total quizzes attempted
-	CorrectAnswers int    `gorm:"default:0"` // Number of correct answers
-	Badges         string `gorm:"type:text"` // JSON string of earned badge IDs
-	User           User   `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	UserID         string     `gorm:"primaryKey"`
+	Experience     int        `gorm:"default:0"` // XP points
+	Level          int        `gorm:"default:1"` // User's level
+	CurrentStreak  int        `gorm:"default:0"` // Consecutive active days
+	LongestStreak  int        `gorm:"default:0"` // Highest streak ever
+	QuizzesPlayed  int        `gorm:"default:0"` // Total quizzes attempted
+	CorrectAnswers int        `gorm:"default:0"` // Number of correct answers
+	LastActiveOn   *time.Time `gorm:"type:date"` // Streak day of the latest activity
+	User           User       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
 }
 
 type Admin struct {
-	UserID       string `gorm:"primaryKey"`
-	Permissions  string `gorm:"type:text"` // JSON string defining admin permissions
-	ManagedUsers int    `gorm:"default:0"` // Number of users managed
-	User         User   `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	UserID string `gorm:"primaryKey"`
+	User   User   `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// PermissionGrant gives one user a permission on top of their role.
+// Permission holds the authz.Permission enum name, e.g. "PERMISSION_QUESTIONS_WRITE".
+type PermissionGrant struct {
+	ID         uint      `gorm:"primaryKey"`
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_grant_user_permission"`
+	Permission string    `gorm:"size:64;not null;uniqueIndex:idx_grant_user_permission"`
+	GrantedBy  string    `gorm:"size:64"` // User ID of the admin who granted it
+	CreatedAt  time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	User       User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
 }
 
 func Migrate(db *gorm.DB) error {
-	return db.AutoMigrate(&Mentee{}, &Admin{})
+	if err := db.AutoMigrate(&Mentee{}, &Admin{}, &Mentor{}, &PermissionGrant{}, &Cohort{}, &CohortMember{},
+		&BadgeDefinition{}, &UserBadge{}, &MenteeActivity{}, &Avatar{}, &AcademicProfile{}); err != nil {
+		return err
+	}
+	if err := seedBadges(db); err != nil {
+		return err
+	}
+	if err := migrateLegacyBadges(db); err != nil {
+		return err
+	}
+	if err := migrateLegacyAdminPermissions(db); err != nil {
+		return err
+	}
+	// Managed users are now counted from cohort membership
+	if db.Migrator().HasColumn(&Admin{}, "managed_users") {
+		if err := db.Migrator().DropColumn(&Admin{}, "managed_users"); err != nil {
+			return err
+		}
+	}
+	return backfillProfiles(db)
+}
+
+// backfillProfiles creates the missing profiles of users registered before
+// auth-service published UserRegistered events
+func backfillProfiles(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO mentees (user_id) SELECT id::text FROM users WHERE role = 'student' ON CONFLICT DO NOTHING;
+		INSERT INTO admins (user_id) SELECT id::text FROM users WHERE role = 'admin' ON CONFLICT DO NOTHING;
+		INSERT INTO mentors (user_id) SELECT id::text FROM users WHERE role = 'mentor' ON CONFLICT DO NOTHING;`).Error
+}
+
+// migrateLegacyAdminPermissions converts the old admins.permissions JSON array
+// into permission_grants rows and drops the column
+func migrateLegacyAdminPermissions(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&Admin{}, "permissions") {
+		return nil
+	}
+
+	var rows []struct {
+		UserID      string
+		Permissions string
+	}
+	if err := db.Table("admins").Select("user_id, permissions").Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, row := range rows {
+			userID, err := strconv.ParseUint(row.UserID, 10, 64)
+			if err != nil {
+				continue
+			}
+			var names []string
+			if err := json.Unmarshal([]byte(row.Permissions), &names); err != nil {
+				continue
+			}
+			for _, name := range names {
+				permission := authz.ParsePermission(name)
+				if permission == authzpb.Permission_PERMISSION_UNSPECIFIED {
+					continue
+				}
+				grant := PermissionGrant{UserID: uint(userID), Permission: permission.String()}
+				if err := tx.Where(grant).FirstOrCreate(&grant).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Migrator().DropColumn(&Admin{}, "permissions")
+	})
 }
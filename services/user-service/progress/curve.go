@@ -0,0 +1,171 @@
+// Package progress holds the rules that turn quiz activity into XP, levels
+// and streaks
+package progress
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Curve maps total XP to a level. Thresholds[i] is the total XP needed for
+// level i+2 (everyone starts at level 1); past the last threshold each level
+// costs as much as the last step.
+type Curve struct {
+	Thresholds []int
+}
+
+// QuadraticCurve makes level n cost base*n*(n-1)/2 total XP: 100, 300, 600... for base 100
+func QuadraticCurve(base, levels int) Curve {
+	thresholds := make([]int, 0, levels-1)
+	for level := 2; level <= levels; level++ {
+		thresholds = append(thresholds, base*level*(level-1)/2)
+	}
+	return Curve{Thresholds: thresholds}
+}
+
+// ParseCurve reads comma-separated, strictly increasing total XP thresholds
+func ParseCurve(value string) (Curve, error) {
+	var thresholds []int
+	for _, field := range strings.Split(value, ",") {
+		threshold, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || threshold <= 0 {
+			return Curve{}, fmt.Errorf("invalid XP threshold %q", field)
+		}
+		if len(thresholds) > 0 && threshold <= thresholds[len(thresholds)-1] {
+			return Curve{}, fmt.Errorf("XP thresholds must increase (%d after %d)", threshold, thresholds[len(thresholds)-1])
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	return Curve{Thresholds: thresholds}, nil
+}
+
+// Level returns the level reached with the given total XP
+func (c Curve) Level(experience int) int {
+	reached := sort.Search(len(c.Thresholds), func(i int) bool { return c.Thresholds[i] > experience })
+	if reached < len(c.Thresholds) || len(c.Thresholds) == 0 {
+		return reached + 1
+	}
+	last, step := c.lastStep()
+	return len(c.Thresholds) + 1 + (experience-last)/step
+}
+
+// NextLevelAt returns the total XP needed to reach level+1
+func (c Curve) NextLevelAt(level int) int {
+	if level < 1 {
+		level = 1
+	}
+	if level-1 < len(c.Thresholds) {
+		return c.Thresholds[level-1]
+	}
+	last, step := c.lastStep()
+	return last + (level-len(c.Thresholds))*step
+}
+
+func (c Curve) lastStep() (last, step int) {
+	last = c.Thresholds[len(c.Thresholds)-1]
+	step = last
+	if len(c.Thresholds) > 1 {
+		step = last - c.Thresholds[len(c.Thresholds)-2]
+	}
+	return last, step
+}
+
+// Rules are the XP awards, the level curve and the day boundary for streaks
+type Rules struct {
+	Curve               Curve
+	XPPerCorrectAnswer  int
+	QuizCompletionBonus int
+	Location            *time.Location // Streak days start at midnight here
+}
+
+// ✅ Load the rules from the environment
+//
+//	LEVEL_XP_THRESHOLDS=100,300,600,1000   # Total XP for level 2, 3, ... (default: quadratic, 100 XP base)
+//	XP_PER_CORRECT_ANSWER=10
+//	XP_QUIZ_COMPLETION_BONUS=20
+//	STREAK_TIMEZONE=Asia/Kolkata           # Default UTC
+func RulesFromEnv() Rules {
+	rules := Rules{
+		Curve:               QuadraticCurve(100, 100),
+		XPPerCorrectAnswer:  intFromEnv("XP_PER_CORRECT_ANSWER", 10),
+		QuizCompletionBonus: intFromEnv("XP_QUIZ_COMPLETION_BONUS", 20),
+		Location:            time.UTC,
+	}
+	if value := os.Getenv("LEVEL_XP_THRESHOLDS"); value != "" {
+		if curve, err := ParseCurve(value); err != nil {
+			log.Printf("⚠️ Ignoring LEVEL_XP_THRESHOLDS: %v", err)
+		} else {
+			rules.Curve = curve
+		}
+	}
+	if name := os.Getenv("STREAK_TIMEZONE"); name != "" {
+		if location, err := time.LoadLocation(name); err != nil {
+			log.Printf("⚠️ Ignoring STREAK_TIMEZONE: %v", err)
+		} else {
+			rules.Location = location
+		}
+	}
+	return rules
+}
+
+// Experience is the XP an activity earns
+func (r Rules) Experience(correctAnswers int, quizCompleted bool) int {
+	experience := correctAnswers * r.XPPerCorrectAnswer
+	if quizCompleted {
+		experience += r.QuizCompletionBonus
+	}
+	return experience
+}
+
+// Day returns the streak day of t: its date in Location, as midnight UTC
+// (the form stored in mentees.last_active_on)
+func (r Rules) Day(t time.Time) time.Time {
+	year, month, day := t.In(r.Location).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// ExtendStreak returns the streak after activity at `at`, given the streak
+// and last active day so far (nil if never active). Activity on the next day
+// extends it, after a gap restarts it; backdated activity leaves it alone.
+func (r Rules) ExtendStreak(streak int, lastActive *time.Time, at time.Time) (int, time.Time) {
+	day := r.Day(at)
+	if lastActive == nil {
+		return 1, day
+	}
+	switch gap := daysBetween(*lastActive, day); {
+	case gap < 0:
+		return streak, *lastActive
+	case gap == 0:
+		return max(streak, 1), day
+	case gap == 1:
+		return streak + 1, day
+	default:
+		return 1, day
+	}
+}
+
+// CurrentStreak is the streak as of now: it lapses once a whole day passes without activity
+func (r Rules) CurrentStreak(streak int, lastActive *time.Time, now time.Time) int {
+	if lastActive == nil || daysBetween(*lastActive, r.Day(now)) > 1 {
+		return 0
+	}
+	return streak
+}
+
+func daysBetween(from, to time.Time) int {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func intFromEnv(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value < 0 {
+		return fallback
+	}
+	return value
+}
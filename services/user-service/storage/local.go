@@ -0,0 +1,178 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Largest body the upload endpoint accepts; callers enforce their own limit
+const maxLocalPutBytes = 32 << 20
+
+// LocalStore keeps objects as files under Dir. Handler serves them at the
+// public URL and accepts uploads to presigned URLs, standing in for a bucket
+// in development.
+type LocalStore struct {
+	Dir        string
+	PublicURL  string // e.g. "http://localhost:8082/media"
+	SigningKey []byte // Signs upload URLs
+}
+
+func NewLocalStore(dir, publicURL string, signingKey []byte) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	if _, err := url.Parse(publicURL); err != nil {
+		return nil, fmt.Errorf("invalid MEDIA_PUBLIC_URL: %w", err)
+	}
+	return &LocalStore{Dir: dir, PublicURL: strings.TrimSuffix(publicURL, "/"), SigningKey: signingKey}, nil
+}
+
+func (s *LocalStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Write next to the target and rename, so readers never see half a file
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStore) URL(key string) string {
+	return joinURL(s.PublicURL, key)
+}
+
+func (s *LocalStore) PresignPut(ctx context.Context, key, contentType string, expires time.Duration) (string, error) {
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+	expiresAt := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	query := url.Values{
+		"expires":   {expiresAt},
+		"signature": {s.sign(key, contentType, expiresAt)},
+	}
+	return s.URL(key) + "?" + query.Encode(), nil
+}
+
+// Handler serves objects under the path of PublicURL and stores the body of
+// PUTs to presigned URLs
+func (s *LocalStore) Handler() http.Handler {
+	prefix := "/"
+	if u, err := url.Parse(s.PublicURL); err == nil {
+		prefix = strings.TrimSuffix(u.Path, "/") + "/"
+	}
+	mux := http.NewServeMux()
+	mux.Handle(prefix, http.StripPrefix(prefix, http.HandlerFunc(s.serve)))
+	return mux
+}
+
+func (s *LocalStore) serve(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Path
+	path, err := s.path(key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		// Keys change with every upload, so objects never change
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		http.ServeFile(w, r, path)
+	case http.MethodPut:
+		query := r.URL.Query()
+		expiresAt, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+		if err != nil || time.Now().Unix() > expiresAt {
+			http.Error(w, "upload URL has expired", http.StatusForbidden)
+			return
+		}
+		expected := s.sign(key, r.Header.Get("Content-Type"), query.Get("expires"))
+		if !hmac.Equal([]byte(expected), []byte(query.Get("signature"))) {
+			http.Error(w, "invalid upload signature", http.StatusForbidden)
+			return
+		}
+		if r.ContentLength > maxLocalPutBytes {
+			http.Error(w, "upload is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		body := http.MaxBytesReader(w, r.Body, maxLocalPutBytes)
+		if err := s.Put(r.Context(), key, body, r.ContentLength, r.Header.Get("Content-Type")); err != nil {
+			http.Error(w, "upload failed", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// sign binds an upload URL to its key, content type and expiry
+func (s *LocalStore) sign(key, contentType, expiresAt string) string {
+	mac := hmac.New(sha256.New, s.SigningKey)
+	mac.Write([]byte(key + "\n" + contentType + "\n" + expiresAt))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path maps a key to its file, rejecting keys that would escape Dir
+func (s *LocalStore) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		// Dot segments also cover "..", and hide the temporary files of Put
+		if segment == "" || strings.HasPrefix(segment, ".") || strings.ContainsRune(segment, '\\') {
+			return "", fmt.Errorf("invalid object key %q", key)
+		}
+	}
+	return filepath.Join(s.Dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,74 @@
+// Package storage keeps user media (avatars) in an object store
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned by Get when the object doesn't exist
+var ErrNotFound = errors.New("object not found")
+
+// Store is an object store. Keys are slash-separated paths such as
+// "avatars/42/9f1c/large.jpg"; objects are publicly readable at URL(key).
+type Store interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get opens an object; the caller closes it
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes an object; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+	// URL is where clients download the object
+	URL(key string) string
+	// PresignPut returns a URL that accepts one PUT of the object until it expires
+	PresignPut(ctx context.Context, key, contentType string, expires time.Duration) (string, error)
+}
+
+// FromEnv builds the store selected by MEDIA_STORE: "local" (default) keeps
+// files under MEDIA_DIR, "s3" uses an S3-compatible bucket such as MinIO.
+func FromEnv() (Store, error) {
+	switch store := os.Getenv("MEDIA_STORE"); store {
+	case "", "local":
+		key := []byte(os.Getenv("MEDIA_SIGNING_KEY"))
+		if len(key) == 0 {
+			log.Println("⚠️ MEDIA_SIGNING_KEY is not set; upload URLs won't survive a restart")
+			key = make([]byte, 32)
+			rand.Read(key)
+		}
+		return NewLocalStore(
+			envOr("MEDIA_DIR", "data/media"),
+			envOr("MEDIA_PUBLIC_URL", "http://localhost:8082/media"),
+			key,
+		)
+	case "s3":
+		return NewS3Store(S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          envOr("S3_REGION", "us-east-1"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			UseSSL:          os.Getenv("S3_USE_SSL") != "false",
+			PublicURL:       os.Getenv("S3_PUBLIC_URL"),
+		})
+	default:
+		return nil, fmt.Errorf("unknown MEDIA_STORE %q", store)
+	}
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// joinURL appends an object key to a base URL
+func joinURL(base, key string) string {
+	return strings.TrimSuffix(base, "/") + "/" + key
+}
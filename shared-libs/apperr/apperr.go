@@ -0,0 +1,211 @@
+// Package apperr is the error model the services share. An Error carries the
+// gRPC code, a stable Reason clients can switch on (USER_NOT_FOUND,
+// INVALID_CREDENTIALS, ...), and optionally the fields that were invalid and
+// how long to wait before retrying.
+//
+// Handlers return *Error as is: gRPC sends it as a status with ErrorInfo,
+// BadRequest and RetryInfo details, and the gateway renders that as
+// application/problem+json with a message in the caller's language.
+package apperr
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain is the ErrorInfo domain of every NeetChamp error
+const Domain = "neetchamp.app"
+
+type Error struct {
+	Code       codes.Code
+	Reason     Reason
+	Message    string // English detail for developers and logs; clients show the localized reason
+	Violations []FieldViolation
+	RetryAfter time.Duration
+	Metadata   map[string]string
+	cause      error
+}
+
+// FieldViolation is one invalid request field, named by its proto path (e.g. "academic.target_year")
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+func New(code codes.Code, reason Reason, message string) *Error {
+	return &Error{Code: code, Reason: reason, Message: message}
+}
+
+func InvalidArgument(reason Reason, message string) *Error {
+	return New(codes.InvalidArgument, reason, message)
+}
+
+// InvalidField reports one bad request field
+func InvalidField(field, description string) *Error {
+	return InvalidArgument(ReasonInvalidField, description).WithField(field, description)
+}
+
+func NotFound(reason Reason, message string) *Error {
+	return New(codes.NotFound, reason, message)
+}
+
+func AlreadyExists(reason Reason, message string) *Error {
+	return New(codes.AlreadyExists, reason, message)
+}
+
+func FailedPrecondition(reason Reason, message string) *Error {
+	return New(codes.FailedPrecondition, reason, message)
+}
+
+func Unauthenticated(reason Reason, message string) *Error {
+	return New(codes.Unauthenticated, reason, message)
+}
+
+// PermissionDenied is always reason PERMISSION_DENIED; message says what was missing
+func PermissionDenied(message string) *Error {
+	return New(codes.PermissionDenied, ReasonPermissionDenied, message)
+}
+
+func Aborted(reason Reason, message string) *Error {
+	return New(codes.Aborted, reason, message)
+}
+
+// ResourceExhausted asks the client to wait retryAfter before trying again
+func ResourceExhausted(reason Reason, message string, retryAfter time.Duration) *Error {
+	return New(codes.ResourceExhausted, reason, message).WithRetryAfter(retryAfter)
+}
+
+func Unavailable(message string) *Error {
+	return New(codes.Unavailable, ReasonUnavailable, message)
+}
+
+func Unimplemented(reason Reason, message string) *Error {
+	return New(codes.Unimplemented, reason, message)
+}
+
+// Internal is a failure that isn't the client's fault. Keep message generic;
+// attach the underlying error with Wrap for logs.
+func Internal(message string) *Error {
+	return New(codes.Internal, ReasonInternal, message)
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Message + ": " + e.cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is matches any error with the same code and reason, so errors.Is works on
+// sentinels after WithField, Wrap, etc.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code && t.Reason == e.Reason
+}
+
+// The With* methods return a copy, so package-level sentinels stay untouched
+
+// WithMessage replaces the English detail, e.g. with the limits that were exceeded
+func (e *Error) WithMessage(format string, args ...interface{}) *Error {
+	c := e.clone()
+	c.Message = fmt.Sprintf(format, args...)
+	return c
+}
+
+func (e *Error) WithField(field, description string) *Error {
+	c := e.clone()
+	c.Violations = append(c.Violations, FieldViolation{Field: field, Description: description})
+	return c
+}
+
+func (e *Error) WithRetryAfter(retryAfter time.Duration) *Error {
+	c := e.clone()
+	c.RetryAfter = retryAfter
+	return c
+}
+
+func (e *Error) WithMetadata(key, value string) *Error {
+	c := e.clone()
+	if c.Metadata == nil {
+		c.Metadata = map[string]string{}
+	}
+	c.Metadata[key] = value
+	return c
+}
+
+// Wrap records the underlying error; it's logged, never sent to clients
+func (e *Error) Wrap(cause error) *Error {
+	c := e.clone()
+	c.cause = cause
+	return c
+}
+
+func (e *Error) clone() *Error {
+	c := *e
+	c.Violations = slices.Clone(e.Violations)
+	c.Metadata = maps.Clone(e.Metadata)
+	return &c
+}
+
+// GRPCStatus lets grpc-go (and status.FromError) turn the error into a status
+func (e *Error) GRPCStatus() *status.Status {
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: string(e.Reason), Domain: Domain, Metadata: e.Metadata}}
+	if len(e.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, violation := range e.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	if e.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)})
+	}
+
+	st := status.New(e.Code, e.Message)
+	if detailed, err := st.WithDetails(details...); err == nil {
+		return detailed
+	}
+	return st
+}
+
+// FromError recovers an Error from anything a gRPC call returned: the details
+// of the status are read back, and errors without an ErrorInfo get the
+// generic reason of their code
+func FromError(err error) *Error {
+	if e, ok := err.(*Error); ok {
+		return e
+	}
+	st := status.Convert(err)
+	e := &Error{Code: st.Code(), Reason: ReasonForCode(st.Code()), Message: st.Message()}
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if d.Reason != "" {
+				e.Reason = Reason(d.Reason)
+			}
+			e.Metadata = d.Metadata
+		case *errdetails.BadRequest:
+			for _, violation := range d.FieldViolations {
+				e.Violations = append(e.Violations, FieldViolation{Field: violation.Field, Description: violation.Description})
+			}
+		case *errdetails.RetryInfo:
+			e.RetryAfter = d.RetryDelay.AsDuration()
+		}
+	}
+	return e
+}
@@ -0,0 +1,48 @@
+package apperr
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor logs internal errors with their cause, and hides
+// errors that aren't statuses (a stray errors.New from a library) behind a
+// generic INTERNAL so their text never reaches clients
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, normalize(info.FullMethod, err)
+	}
+}
+
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return normalize(info.FullMethod, handler(srv, ss))
+	}
+}
+
+func normalize(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		if e.Code == codes.Internal || e.Code == codes.Unknown {
+			log.Printf("⚠️ %s: %v", method, err)
+		}
+		return e
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	log.Printf("⚠️ %s: %v", method, err)
+	return Internal("internal error").Wrap(err)
+}
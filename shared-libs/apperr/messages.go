@@ -0,0 +1,115 @@
+package apperr
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DefaultLanguage is used when the caller accepts none of Languages
+const DefaultLanguage = "en"
+
+// Languages the messages are translated to, matching the app's Language enum
+var Languages = []string{"en", "hi"}
+
+// messages is what clients show for each reason. Keep them short, without
+// internal detail, and add every new reason in each language.
+var messages = map[Reason]map[string]string{
+	ReasonInvalidArgument:    {"en": "The request is invalid.", "hi": "अनुरोध अमान्य है।"},
+	ReasonNotFound:           {"en": "Not found.", "hi": "नहीं मिला।"},
+	ReasonAlreadyExists:      {"en": "It already exists.", "hi": "यह पहले से मौजूद है।"},
+	ReasonPermissionDenied:   {"en": "You don't have permission to do this.", "hi": "आपको यह करने की अनुमति नहीं है।"},
+	ReasonUnauthenticated:    {"en": "Please sign in.", "hi": "कृपया साइन इन करें।"},
+	ReasonFailedPrecondition: {"en": "This can't be done right now.", "hi": "यह अभी नहीं किया जा सकता।"},
+	ReasonAborted:            {"en": "The request conflicted with another change. Please try again.", "hi": "अनुरोध किसी अन्य बदलाव से टकरा गया। कृपया फिर से प्रयास करें।"},
+	ReasonResourceExhausted:  {"en": "Too many requests. Please try again later.", "hi": "बहुत अधिक अनुरोध। कृपया बाद में प्रयास करें।"},
+	ReasonUnimplemented:      {"en": "This isn't supported.", "hi": "यह समर्थित नहीं है।"},
+	ReasonUnavailable:        {"en": "The service is temporarily unavailable. Please try again.", "hi": "सेवा अस्थायी रूप से उपलब्ध नहीं है। कृपया फिर से प्रयास करें।"},
+	ReasonDeadlineExceeded:   {"en": "The request took too long. Please try again.", "hi": "अनुरोध में बहुत समय लगा। कृपया फिर से प्रयास करें।"},
+	ReasonCanceled:           {"en": "The request was cancelled.", "hi": "अनुरोध रद्द कर दिया गया।"},
+	ReasonInternal:           {"en": "Something went wrong. Please try again.", "hi": "कुछ गलत हो गया। कृपया फिर से प्रयास करें।"},
+
+	ReasonInvalidField:     {"en": "Some fields are invalid.", "hi": "कुछ फ़ील्ड अमान्य हैं।"},
+	ReasonInvalidPageToken: {"en": "The page token is invalid.", "hi": "पेज टोकन अमान्य है।"},
+
+	ReasonAuthenticationRequired:  {"en": "Please sign in.", "hi": "कृपया साइन इन करें।"},
+	ReasonInvalidAccessToken:      {"en": "Your session has expired. Please sign in again.", "hi": "आपका सत्र समाप्त हो गया है। कृपया फिर से साइन इन करें।"},
+	ReasonInvalidCredentials:      {"en": "The email or password is incorrect.", "hi": "ईमेल या पासवर्ड गलत है।"},
+	ReasonEmailTaken:              {"en": "An account with this email already exists.", "hi": "इस ईमेल से एक खाता पहले से मौजूद है।"},
+	ReasonEmailNotVerified:        {"en": "Please verify your email address first.", "hi": "कृपया पहले अपना ईमेल पता सत्यापित करें।"},
+	ReasonInvalidRefreshToken:     {"en": "Your session has expired. Please sign in again.", "hi": "आपका सत्र समाप्त हो गया है। कृपया फिर से साइन इन करें।"},
+	ReasonRefreshTokenRevoked:     {"en": "You were signed out. Please sign in again.", "hi": "आपको साइन आउट कर दिया गया। कृपया फिर से साइन इन करें।"},
+	ReasonRefreshTokenExpired:     {"en": "Your session has expired. Please sign in again.", "hi": "आपका सत्र समाप्त हो गया है। कृपया फिर से साइन इन करें।"},
+	ReasonRefreshTokenReused:      {"en": "For your security you were signed out. Please sign in again.", "hi": "आपकी सुरक्षा के लिए आपको साइन आउट कर दिया गया। कृपया फिर से साइन इन करें।"},
+	ReasonInvalidResetToken:       {"en": "This password reset link is invalid or has expired.", "hi": "यह पासवर्ड रीसेट लिंक अमान्य है या समाप्त हो गया है।"},
+	ReasonInvalidVerifyToken:      {"en": "This verification link is invalid or has expired.", "hi": "यह सत्यापन लिंक अमान्य है या समाप्त हो गया है।"},
+	ReasonWeakPassword:            {"en": "The password must be at least 8 characters.", "hi": "पासवर्ड कम से कम 8 अक्षरों का होना चाहिए।"},
+	ReasonSessionNotFound:         {"en": "This session no longer exists.", "hi": "यह सत्र अब मौजूद नहीं है।"},
+	ReasonInvalidLoginChallenge:   {"en": "The sign-in attempt has expired. Please sign in again.", "hi": "साइन इन का प्रयास समाप्त हो गया है। कृपया फिर से साइन इन करें।"},
+	ReasonInvalidSecondFactor:     {"en": "The authentication code is incorrect.", "hi": "प्रमाणीकरण कोड गलत है।"},
+	ReasonTwoFactorNotEnabled:     {"en": "Two-factor authentication is not enabled.", "hi": "दो-चरणीय प्रमाणीकरण सक्षम नहीं है।"},
+	ReasonTwoFactorAlreadyEnabled: {"en": "Two-factor authentication is already enabled.", "hi": "दो-चरणीय प्रमाणीकरण पहले से सक्षम है।"},
+	ReasonTwoFactorRequired:       {"en": "Two-factor authentication is required for your account.", "hi": "आपके खाते के लिए दो-चरणीय प्रमाणीकरण आवश्यक है।"},
+	ReasonNoPendingEnrollment:     {"en": "Start two-factor setup first.", "hi": "पहले दो-चरणीय प्रमाणीकरण सेटअप शुरू करें।"},
+	ReasonUnsupportedProvider:     {"en": "Signing in with this provider isn't supported.", "hi": "इस प्रदाता से साइन इन समर्थित नहीं है।"},
+	ReasonInvalidProviderToken:    {"en": "Signing in with the provider failed. Please try again.", "hi": "प्रदाता से साइन इन विफल रहा। कृपया फिर से प्रयास करें।"},
+	ReasonProviderEmailUnverified: {"en": "Your provider account has no verified email address.", "hi": "आपके प्रदाता खाते में कोई सत्यापित ईमेल पता नहीं है।"},
+	ReasonRateLimited:             {"en": "Too many attempts. Please try again later.", "hi": "बहुत अधिक प्रयास। कृपया बाद में प्रयास करें।"},
+	ReasonAccountLocked:           {"en": "Too many failed attempts. Please try again later.", "hi": "बहुत अधिक असफल प्रयास। कृपया बाद में प्रयास करें।"},
+
+	ReasonUserNotFound:         {"en": "User not found.", "hi": "उपयोगकर्ता नहीं मिला।"},
+	ReasonUserModified:         {"en": "The profile was changed elsewhere. Reload it and try again.", "hi": "प्रोफ़ाइल कहीं और बदली गई है। इसे फिर से लोड करें और प्रयास करें।"},
+	ReasonNothingToRestore:     {"en": "There is no deleted account to restore.", "hi": "पुनर्स्थापित करने के लिए कोई हटाया गया खाता नहीं है।"},
+	ReasonWrongRole:            {"en": "This isn't available for this type of account.", "hi": "यह इस प्रकार के खाते के लिए उपलब्ध नहीं है।"},
+	ReasonMentorNotFound:       {"en": "Mentor not found.", "hi": "मेंटर नहीं मिला।"},
+	ReasonCohortNotFound:       {"en": "Cohort not found.", "hi": "बैच नहीं मिला।"},
+	ReasonInvalidInviteCode:    {"en": "The invite code is invalid.", "hi": "आमंत्रण कोड अमान्य है।"},
+	ReasonAlreadyInCohort:      {"en": "You are already in another cohort. Ask your mentor to transfer you.", "hi": "आप पहले से किसी अन्य बैच में हैं। स्थानांतरण के लिए अपने मेंटर से कहें।"},
+	ReasonOnboardingRequired:   {"en": "Please complete your profile setup first.", "hi": "कृपया पहले अपनी प्रोफ़ाइल सेटअप पूरा करें।"},
+	ReasonAvatarNotFound:       {"en": "There is no profile photo.", "hi": "कोई प्रोफ़ाइल फ़ोटो नहीं है।"},
+	ReasonUploadNotFound:       {"en": "The upload was not found. Please upload the photo again.", "hi": "अपलोड नहीं मिला। कृपया फ़ोटो फिर से अपलोड करें।"},
+	ReasonUnsupportedImageType: {"en": "Use a JPEG, PNG, GIF or WebP image.", "hi": "JPEG, PNG, GIF या WebP छवि का उपयोग करें।"},
+	ReasonImageTooLarge:        {"en": "The image is too large.", "hi": "छवि बहुत बड़ी है।"},
+	ReasonInvalidImage:         {"en": "The image could not be read.", "hi": "छवि पढ़ी नहीं जा सकी।"},
+	ReasonMediaDisabled:        {"en": "Photo uploads are not available.", "hi": "फ़ोटो अपलोड उपलब्ध नहीं है।"},
+
+	ReasonRequestTooLarge: {"en": "The request is too large.", "hi": "अनुरोध बहुत बड़ा है।"},
+	ReasonInvalidAPIKey:   {"en": "The API key is invalid.", "hi": "API कुंजी अमान्य है।"},
+	ReasonQuotaExceeded:   {"en": "The daily quota for this API key is used up.", "hi": "इस API कुंजी का दैनिक कोटा समाप्त हो गया है।"},
+}
+
+// Localized is the text clients show for the error in lang, falling back to
+// English and then to the generic message of its code
+func (e *Error) Localized(lang string) string {
+	for _, reason := range []Reason{e.Reason, ReasonForCode(e.Code), ReasonInternal} {
+		if translations, ok := messages[reason]; ok {
+			if message, ok := translations[lang]; ok {
+				return message
+			}
+			return translations[DefaultLanguage]
+		}
+	}
+	return ""
+}
+
+// NegotiateLanguage picks the best of Languages for an Accept-Language header
+// such as "hi-IN,hi;q=0.9,en;q=0.8"
+func NegotiateLanguage(acceptLanguage string) string {
+	best, bestQ := DefaultLanguage, 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		if q > bestQ && slices.Contains(Languages, primary) {
+			best, bestQ = primary, q
+		}
+	}
+	return best
+}
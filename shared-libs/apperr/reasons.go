@@ -0,0 +1,117 @@
+package apperr
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Reason is a stable, machine-readable error code. Clients switch on it, so
+// never rename one; add a new reason instead.
+type Reason string
+
+// Generic reasons, one per gRPC code, for errors without a specific one
+const (
+	ReasonInvalidArgument    Reason = "INVALID_ARGUMENT"
+	ReasonNotFound           Reason = "NOT_FOUND"
+	ReasonAlreadyExists      Reason = "ALREADY_EXISTS"
+	ReasonPermissionDenied   Reason = "PERMISSION_DENIED"
+	ReasonUnauthenticated    Reason = "UNAUTHENTICATED"
+	ReasonFailedPrecondition Reason = "FAILED_PRECONDITION"
+	ReasonAborted            Reason = "ABORTED"
+	ReasonResourceExhausted  Reason = "RESOURCE_EXHAUSTED"
+	ReasonUnimplemented      Reason = "UNIMPLEMENTED"
+	ReasonUnavailable        Reason = "UNAVAILABLE"
+	ReasonDeadlineExceeded   Reason = "DEADLINE_EXCEEDED"
+	ReasonCanceled           Reason = "CANCELED"
+	ReasonInternal           Reason = "INTERNAL"
+)
+
+// Request validation
+const (
+	ReasonInvalidField     Reason = "INVALID_FIELD"
+	ReasonInvalidPageToken Reason = "INVALID_PAGE_TOKEN"
+)
+
+// Authentication and accounts (auth-service)
+const (
+	ReasonAuthenticationRequired  Reason = "AUTHENTICATION_REQUIRED"
+	ReasonInvalidAccessToken      Reason = "INVALID_ACCESS_TOKEN"
+	ReasonInvalidCredentials      Reason = "INVALID_CREDENTIALS"
+	ReasonEmailTaken              Reason = "EMAIL_TAKEN"
+	ReasonEmailNotVerified        Reason = "EMAIL_NOT_VERIFIED"
+	ReasonInvalidRefreshToken     Reason = "INVALID_REFRESH_TOKEN"
+	ReasonRefreshTokenRevoked     Reason = "REFRESH_TOKEN_REVOKED"
+	ReasonRefreshTokenExpired     Reason = "REFRESH_TOKEN_EXPIRED"
+	ReasonRefreshTokenReused      Reason = "REFRESH_TOKEN_REUSED"
+	ReasonInvalidResetToken       Reason = "INVALID_RESET_TOKEN"
+	ReasonInvalidVerifyToken      Reason = "INVALID_VERIFICATION_TOKEN"
+	ReasonWeakPassword            Reason = "WEAK_PASSWORD"
+	ReasonSessionNotFound         Reason = "SESSION_NOT_FOUND"
+	ReasonInvalidLoginChallenge   Reason = "INVALID_LOGIN_CHALLENGE"
+	ReasonInvalidSecondFactor     Reason = "INVALID_SECOND_FACTOR"
+	ReasonTwoFactorNotEnabled     Reason = "TWO_FACTOR_NOT_ENABLED"
+	ReasonTwoFactorAlreadyEnabled Reason = "TWO_FACTOR_ALREADY_ENABLED"
+	ReasonTwoFactorRequired       Reason = "TWO_FACTOR_REQUIRED"
+	ReasonNoPendingEnrollment     Reason = "NO_PENDING_TWO_FACTOR_ENROLLMENT"
+	ReasonUnsupportedProvider     Reason = "UNSUPPORTED_LOGIN_PROVIDER"
+	ReasonInvalidProviderToken    Reason = "INVALID_PROVIDER_TOKEN"
+	ReasonProviderEmailUnverified Reason = "PROVIDER_EMAIL_UNVERIFIED"
+	ReasonRateLimited             Reason = "RATE_LIMITED"
+	ReasonAccountLocked           Reason = "ACCOUNT_LOCKED"
+)
+
+// Users, cohorts and media (user-service)
+const (
+	ReasonUserNotFound         Reason = "USER_NOT_FOUND"
+	ReasonUserModified         Reason = "USER_MODIFIED"
+	ReasonNothingToRestore     Reason = "NOTHING_TO_RESTORE"
+	ReasonWrongRole            Reason = "WRONG_ROLE"
+	ReasonMentorNotFound       Reason = "MENTOR_NOT_FOUND"
+	ReasonCohortNotFound       Reason = "COHORT_NOT_FOUND"
+	ReasonInvalidInviteCode    Reason = "INVALID_INVITE_CODE"
+	ReasonAlreadyInCohort      Reason = "ALREADY_IN_COHORT"
+	ReasonOnboardingRequired   Reason = "ONBOARDING_REQUIRED"
+	ReasonAvatarNotFound       Reason = "AVATAR_NOT_FOUND"
+	ReasonUploadNotFound       Reason = "UPLOAD_NOT_FOUND"
+	ReasonUnsupportedImageType Reason = "UNSUPPORTED_IMAGE_TYPE"
+	ReasonImageTooLarge        Reason = "IMAGE_TOO_LARGE"
+	ReasonInvalidImage         Reason = "INVALID_IMAGE"
+	ReasonMediaDisabled        Reason = "MEDIA_DISABLED"
+)
+
+// Gateway
+const (
+	ReasonRequestTooLarge Reason = "REQUEST_TOO_LARGE"
+	ReasonInvalidAPIKey   Reason = "INVALID_API_KEY"
+	ReasonQuotaExceeded   Reason = "QUOTA_EXCEEDED"
+)
+
+var codeReasons = map[codes.Code]Reason{
+	codes.InvalidArgument:    ReasonInvalidArgument,
+	codes.OutOfRange:         ReasonInvalidArgument,
+	codes.NotFound:           ReasonNotFound,
+	codes.AlreadyExists:      ReasonAlreadyExists,
+	codes.PermissionDenied:   ReasonPermissionDenied,
+	codes.Unauthenticated:    ReasonUnauthenticated,
+	codes.FailedPrecondition: ReasonFailedPrecondition,
+	codes.Aborted:            ReasonAborted,
+	codes.ResourceExhausted:  ReasonResourceExhausted,
+	codes.Unimplemented:      ReasonUnimplemented,
+	codes.Unavailable:        ReasonUnavailable,
+	codes.DeadlineExceeded:   ReasonDeadlineExceeded,
+	codes.Canceled:           ReasonCanceled,
+}
+
+// ReasonForCode is the generic reason of a gRPC code
+func ReasonForCode(code codes.Code) Reason {
+	if reason, ok := codeReasons[code]; ok {
+		return reason
+	}
+	return ReasonInternal
+}
+
+// Slug is the reason as a URL path segment, e.g. "user-not-found"
+func (r Reason) Slug() string {
+	return strings.ReplaceAll(strings.ToLower(string(r)), "_", "-")
+}
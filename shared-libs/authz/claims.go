@@ -0,0 +1,25 @@
+// Package authz verifies NeetChamp access tokens in gRPC services and exposes
+// the authenticated caller through the request context.
+package authz
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims carried by every access token issued by auth-service.
+// The JWT subject is the user ID.
+type Claims struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// UserID returns the authenticated user's ID (the token subject)
+func (c *Claims) UserID() string {
+	return c.Subject
+}
+
+// SessionID returns the auth-service session the token was issued for (the `jti`)
+func (c *Claims) SessionID() string {
+	return c.ID
+}
@@ -0,0 +1,50 @@
+package authz
+
+import (
+	"context"
+)
+
+type claimsKey struct{}
+
+// NewContext attaches verified claims to the context
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext returns the verified claims of the caller, if any
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok && claims != nil
+}
+
+// UserID returns the caller's user ID, or "" for unauthenticated calls
+func UserID(ctx context.Context) string {
+	if claims, ok := FromContext(ctx); ok {
+		return claims.UserID()
+	}
+	return ""
+}
+
+// UserEmail returns the caller's email, or "" for unauthenticated calls
+func UserEmail(ctx context.Context) string {
+	if claims, ok := FromContext(ctx); ok {
+		return claims.Email
+	}
+	return ""
+}
+
+// UserRole returns the caller's role, or "" for unauthenticated calls
+func UserRole(ctx context.Context) string {
+	if claims, ok := FromContext(ctx); ok {
+		return claims.Role
+	}
+	return ""
+}
+
+// SessionID returns the caller's session ID, or "" for unauthenticated calls
+func SessionID(ctx context.Context) string {
+	if claims, ok := FromContext(ctx); ok {
+		return claims.SessionID()
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+package authz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// Interceptor authenticates incoming gRPC calls with a bearer access token.
+// Verified claims are stored in the context (see FromContext, UserID, ...).
+type Interceptor struct {
+	verifier Verifier
+	public   []string
+}
+
+// NewInterceptor creates an interceptor that requires a valid token on every
+// method except publicMethods. Entries are full method names such as
+// "/auth.AuthService/Login", or a service prefix ending in "/" such as
+// "/grpc.reflection.v1.ServerReflection/" to allow a whole service.
+func NewInterceptor(verifier Verifier, publicMethods ...string) *Interceptor {
+	return &Interceptor{verifier: verifier, public: publicMethods}
+}
+
+// Unary returns the unary server interceptor
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream server interceptor
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// IsPublic reports whether a method is reachable without a token
+func (i *Interceptor) IsPublic(fullMethod string) bool {
+	for _, method := range i.public {
+		if method == fullMethod || (strings.HasSuffix(method, "/") && strings.HasPrefix(fullMethod, method)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Interceptor) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	public := i.IsPublic(fullMethod)
+
+	token, err := BearerToken(ctx)
+	if err != nil {
+		if public {
+			return ctx, nil
+		}
+		return nil, err
+	}
+
+	claims, err := i.verifier.Verify(ctx, token)
+	if err != nil {
+		if public {
+			// A stale token shouldn't block Login/Register
+			return ctx, nil
+		}
+		if appErr, ok := err.(*apperr.Error); ok && appErr.Code == codes.Unavailable {
+			// The token couldn't be checked, which isn't the caller's fault
+			return nil, appErr
+		}
+		return nil, apperr.Unauthenticated(apperr.ReasonInvalidAccessToken, "invalid or expired token")
+	}
+
+	return NewContext(ctx, claims), nil
+}
+
+// BearerToken extracts the token from the "authorization" metadata header
+func BearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", apperr.Unauthenticated(apperr.ReasonAuthenticationRequired, "missing metadata")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", apperr.Unauthenticated(apperr.ReasonAuthenticationRequired, "authorization token not provided")
+	}
+
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", apperr.Unauthenticated(apperr.ReasonInvalidAccessToken, "authorization header must be a bearer token")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// wrappedStream overrides the context of a server stream
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
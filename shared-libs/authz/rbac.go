@@ -0,0 +1,156 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+	authzpb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/authz"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// GrantStore returns permissions granted to an individual user on top of
+// their role (e.g. user-service's permission_grants table)
+type GrantStore interface {
+	Grants(ctx context.Context, userID string) ([]authzpb.Permission, error)
+}
+
+// Enforcer checks each RPC against the `(authz.access)` rule declared on its
+// proto method. Methods without a rule only require authentication. Install
+// it after the authentication Interceptor.
+type Enforcer struct {
+	grants GrantStore
+	rules  sync.Map // full method -> *authzpb.AccessRule (nil if none)
+}
+
+// NewEnforcer creates an enforcer; grants may be nil when a service has no
+// per-user grants
+func NewEnforcer(grants GrantStore) *Enforcer {
+	return &Enforcer{grants: grants}
+}
+
+// Unary returns the unary server interceptor
+func (e *Enforcer) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = e.withPermissions(ctx)
+		msg, _ := req.(proto.Message)
+		if err := e.check(ctx, info.FullMethod, msg); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream server interceptor. Streams have no request
+// message up front, so self_field never applies to them.
+func (e *Enforcer) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := e.withPermissions(ss.Context())
+		if err := e.check(ctx, info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (e *Enforcer) check(ctx context.Context, fullMethod string, req proto.Message) error {
+	rule := e.rule(fullMethod)
+	if rule == nil || len(rule.Permissions) == 0 {
+		return nil
+	}
+
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return apperr.Unauthenticated(apperr.ReasonAuthenticationRequired, "authentication required")
+	}
+
+	if rule.SelfField != "" && req != nil && isSelf(req, rule.SelfField, claims.UserID()) {
+		return nil
+	}
+
+	for _, permission := range rule.Permissions {
+		if !HasPermission(ctx, permission) {
+			return apperr.PermissionDenied(fmt.Sprintf("missing permission %s", permission)).WithMetadata("permission", permission.String())
+		}
+	}
+	return nil
+}
+
+// rule looks up the access rule declared on a method, e.g. "/user.UserService/GetUser"
+func (e *Enforcer) rule(fullMethod string) *authzpb.AccessRule {
+	if cached, ok := e.rules.Load(fullMethod); ok {
+		return cached.(*authzpb.AccessRule)
+	}
+
+	var rule *authzpb.AccessRule
+	name := strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1)
+	if desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name)); err == nil {
+		if method, ok := desc.(protoreflect.MethodDescriptor); ok && method.Options() != nil {
+			rule, _ = proto.GetExtension(method.Options(), authzpb.E_Access).(*authzpb.AccessRule)
+		}
+	}
+
+	e.rules.Store(fullMethod, rule)
+	return rule
+}
+
+// isSelf reports whether the request's self field names the caller (an empty value means "me")
+func isSelf(req proto.Message, fieldName, userID string) bool {
+	msg := req.ProtoReflect()
+	field := msg.Descriptor().Fields().ByName(protoreflect.Name(fieldName))
+	if field == nil || field.Kind() != protoreflect.StringKind || userID == "" {
+		return false
+	}
+	value := msg.Get(field).String()
+	return value == "" || value == userID
+}
+
+type permissionsKey struct{}
+
+// callerPermissions resolves the caller's role permissions plus grants once per request
+type callerPermissions struct {
+	once        sync.Once
+	load        func() map[authzpb.Permission]bool
+	permissions map[authzpb.Permission]bool
+}
+
+func (e *Enforcer) withPermissions(ctx context.Context) context.Context {
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	perms := &callerPermissions{load: func() map[authzpb.Permission]bool {
+		set := map[authzpb.Permission]bool{}
+		for _, permission := range RolePermissions[ParseRole(claims.Role)] {
+			set[permission] = true
+		}
+		if e.grants != nil {
+			granted, err := e.grants.Grants(ctx, claims.UserID())
+			if err != nil {
+				fmt.Println("⚠️ Failed to load permission grants:", err)
+			}
+			for _, permission := range granted {
+				set[permission] = true
+			}
+		}
+		return set
+	}}
+	return context.WithValue(ctx, permissionsKey{}, perms)
+}
+
+// HasPermission reports whether the caller holds a permission, through their
+// role or an individual grant. Requires the Enforcer interceptor.
+func HasPermission(ctx context.Context, permission authzpb.Permission) bool {
+	perms, ok := ctx.Value(permissionsKey{}).(*callerPermissions)
+	if !ok {
+		return false
+	}
+	perms.once.Do(func() { perms.permissions = perms.load() })
+	return perms.permissions[permission]
+}
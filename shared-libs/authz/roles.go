@@ -0,0 +1,89 @@
+package authz
+
+import (
+	"strings"
+
+	authzpb "github.com/Aditya-PS-05/NeetChamp/shared-libs/proto/authz"
+)
+
+// Canonical role names as stored in users.role and carried in access tokens
+const (
+	RoleStudent       = "student"
+	RoleMentor        = "mentor"
+	RoleContentEditor = "content_editor"
+	RoleAdmin         = "admin"
+)
+
+var roleNames = map[authzpb.Role]string{
+	authzpb.Role_ROLE_STUDENT:        RoleStudent,
+	authzpb.Role_ROLE_MENTOR:         RoleMentor,
+	authzpb.Role_ROLE_CONTENT_EDITOR: RoleContentEditor,
+	authzpb.Role_ROLE_ADMIN:          RoleAdmin,
+}
+
+// RolePermissions is the permissions table: what each role can do
+var RolePermissions = map[authzpb.Role][]authzpb.Permission{
+	authzpb.Role_ROLE_STUDENT: {
+		authzpb.Permission_PERMISSION_QUIZZES_PLAY,
+		authzpb.Permission_PERMISSION_QUESTIONS_READ,
+	},
+	authzpb.Role_ROLE_MENTOR: {
+		authzpb.Permission_PERMISSION_QUIZZES_PLAY,
+		authzpb.Permission_PERMISSION_QUESTIONS_READ,
+		authzpb.Permission_PERMISSION_PROFILES_READ,
+		authzpb.Permission_PERMISSION_MENTEES_VIEW,
+		authzpb.Permission_PERMISSION_COHORTS_MANAGE,
+	},
+	authzpb.Role_ROLE_CONTENT_EDITOR: {
+		authzpb.Permission_PERMISSION_QUESTIONS_READ,
+		authzpb.Permission_PERMISSION_QUESTIONS_WRITE,
+	},
+	authzpb.Role_ROLE_ADMIN: {
+		authzpb.Permission_PERMISSION_PROFILES_READ,
+		authzpb.Permission_PERMISSION_PROFILES_WRITE,
+		authzpb.Permission_PERMISSION_USERS_DELETE,
+		authzpb.Permission_PERMISSION_ROLES_MANAGE,
+		authzpb.Permission_PERMISSION_QUESTIONS_READ,
+		authzpb.Permission_PERMISSION_QUESTIONS_WRITE,
+		authzpb.Permission_PERMISSION_QUIZZES_PLAY,
+		authzpb.Permission_PERMISSION_MENTEES_VIEW,
+		authzpb.Permission_PERMISSION_AUDIT_READ,
+		authzpb.Permission_PERMISSION_COHORTS_MANAGE,
+		authzpb.Permission_PERMISSION_ACTIVITY_RECORD,
+	},
+}
+
+// ParseRole maps a stored role name to the Role enum. Accepts the canonical
+// names, enum names ("ROLE_ADMIN") and the legacy "mentee" alias for student.
+func ParseRole(name string) authzpb.Role {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	normalized = strings.TrimPrefix(normalized, "role_")
+	normalized = strings.ReplaceAll(normalized, "-", "_")
+	switch normalized {
+	case RoleStudent, "mentee":
+		return authzpb.Role_ROLE_STUDENT
+	case RoleMentor:
+		return authzpb.Role_ROLE_MENTOR
+	case RoleContentEditor:
+		return authzpb.Role_ROLE_CONTENT_EDITOR
+	case RoleAdmin:
+		return authzpb.Role_ROLE_ADMIN
+	default:
+		return authzpb.Role_ROLE_UNSPECIFIED
+	}
+}
+
+// RoleName returns the canonical stored name of a role ("" if unspecified)
+func RoleName(role authzpb.Role) string {
+	return roleNames[role]
+}
+
+// ParsePermission maps an enum name such as "PERMISSION_QUESTIONS_WRITE"
+// (or "questions_write") to the Permission enum
+func ParsePermission(name string) authzpb.Permission {
+	normalized := strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(normalized, "PERMISSION_") {
+		normalized = "PERMISSION_" + normalized
+	}
+	return authzpb.Permission(authzpb.Permission_value[normalized])
+}
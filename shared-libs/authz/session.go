@@ -0,0 +1,47 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Aditya-PS-05/NeetChamp/shared-libs/apperr"
+)
+
+// SessionStore says whether the session an access token belongs to (its
+// `jti`) was revoked, e.g. from auth-service's sessions table
+type SessionStore interface {
+	SessionRevoked(ctx context.Context, sessionID string) (bool, error)
+}
+
+// SessionStoreFunc adapts a function to the SessionStore interface
+type SessionStoreFunc func(ctx context.Context, sessionID string) (bool, error)
+
+func (f SessionStoreFunc) SessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	return f(ctx, sessionID)
+}
+
+// WithSessionCheck makes a verifier also reject tokens without a session and
+// tokens of revoked sessions, so signing out takes effect in every service
+// rather than when the token expires. When the store fails the call is
+// Unavailable: an outage must not look like a revoked session.
+func WithSessionCheck(verifier Verifier, sessions SessionStore) Verifier {
+	return VerifierFunc(func(ctx context.Context, token string) (*Claims, error) {
+		claims, err := verifier.Verify(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		if claims.SessionID() == "" {
+			return nil, apperr.Unauthenticated(apperr.ReasonInvalidAccessToken, "token has no session")
+		}
+
+		revoked, err := sessions.SessionRevoked(ctx, claims.SessionID())
+		if err != nil {
+			fmt.Println("⚠️ Failed to check the session of an access token:", err)
+			return nil, apperr.Unavailable("session check unavailable").Wrap(err)
+		}
+		if revoked {
+			return nil, apperr.Unauthenticated(apperr.ReasonInvalidAccessToken, "session has been revoked")
+		}
+		return claims, nil
+	})
+}
@@ -0,0 +1,182 @@
+package authz
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrUnknownKey   = errors.New("unknown signing key")
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// SupportedAlgorithms lists the JWT algorithms accepted for access tokens
+var SupportedAlgorithms = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}
+
+// Verifier validates a raw access token and returns its claims
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*Claims, error)
+}
+
+// VerifierFunc adapts a function to the Verifier interface
+type VerifierFunc func(ctx context.Context, token string) (*Claims, error)
+
+func (f VerifierFunc) Verify(ctx context.Context, token string) (*Claims, error) {
+	return f(ctx, token)
+}
+
+// KeyLookup resolves a `kid` to its algorithm and public key
+type KeyLookup func(kid string) (alg string, key crypto.PublicKey, ok bool)
+
+// ParseToken verifies the signature and expiry of a token using the key named
+// by its `kid` header. Tokens with a missing or unknown `kid` are rejected.
+func ParseToken(tokenString string, lookup KeyLookup) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		alg, key, ok := lookup(kid)
+		if !ok {
+			return nil, ErrUnknownKey
+		}
+		if token.Method.Alg() != alg {
+			return nil, errors.New("invalid signing method")
+		}
+		return key, nil
+	}, jwt.WithValidMethods(SupportedAlgorithms))
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// KeySource fetches the current set of verification keys (e.g. auth-service's JWKS)
+type KeySource func(ctx context.Context) ([]JSONWebKey, error)
+
+// HTTPKeySource fetches a JWKS document such as the gateway's /.well-known/jwks.json
+func HTTPKeySource(url string) KeySource {
+	client := &http.Client{Timeout: 10 * time.Second}
+	return func(ctx context.Context) ([]JSONWebKey, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetching JWKS: unexpected status %s", resp.Status)
+		}
+
+		var doc struct {
+			Keys []JSONWebKey `json:"keys"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+			return nil, err
+		}
+		return doc.Keys, nil
+	}
+}
+
+type verificationKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+// RemoteKeySet verifies tokens against keys fetched from a KeySource.
+// Keys are cached and refetched when they go stale or when a token names an
+// unknown `kid` (throttled, so garbage tokens can't hammer auth-service).
+type RemoteKeySet struct {
+	source     KeySource
+	maxAge     time.Duration
+	minRefresh time.Duration
+
+	mu        sync.RWMutex
+	keys      map[string]verificationKey
+	fetchedAt time.Time
+	triedAt   time.Time
+}
+
+// NewRemoteKeySet creates a verifier backed by the given key source
+func NewRemoteKeySet(source KeySource) *RemoteKeySet {
+	return &RemoteKeySet{
+		source:     source,
+		maxAge:     time.Hour,
+		minRefresh: 30 * time.Second,
+		keys:       map[string]verificationKey{},
+	}
+}
+
+// Verify implements Verifier
+func (s *RemoteKeySet) Verify(ctx context.Context, token string) (*Claims, error) {
+	return ParseToken(token, s.KeyLookup(ctx))
+}
+
+// KeyLookup resolves keys from the set, e.g. to verify tokens with other claims
+// such as OpenID Connect ID tokens
+func (s *RemoteKeySet) KeyLookup(ctx context.Context) KeyLookup {
+	return func(kid string) (string, crypto.PublicKey, bool) {
+		key, ok := s.lookup(ctx, kid)
+		return key.alg, key.key, ok
+	}
+}
+
+func (s *RemoteKeySet) lookup(ctx context.Context, kid string) (verificationKey, bool) {
+	s.mu.RLock()
+	key, ok := s.keys[kid]
+	stale := time.Since(s.fetchedAt) > s.maxAge
+	s.mu.RUnlock()
+	if ok && !stale {
+		return key, true
+	}
+
+	s.refresh(ctx)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok = s.keys[kid]
+	return key, ok
+}
+
+func (s *RemoteKeySet) refresh(ctx context.Context) {
+	s.mu.Lock()
+	if time.Since(s.triedAt) < s.minRefresh {
+		s.mu.Unlock()
+		return
+	}
+	s.triedAt = time.Now()
+	s.mu.Unlock()
+
+	jwks, err := s.source(ctx)
+	if err != nil {
+		fmt.Println("⚠️ Failed to fetch JWKS:", err)
+		return
+	}
+
+	keys := make(map[string]verificationKey, len(jwks))
+	for _, jwk := range jwks {
+		public, err := jwk.PublicKey()
+		if err != nil {
+			fmt.Printf("⚠️ Skipping JWK %s: %v\n", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = verificationKey{alg: jwk.algorithm(), key: public}
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	s.mu.Unlock()
+}
@@ -0,0 +1,46 @@
+// convert turns the merged OpenAPI v2 document into OpenAPI 3.
+//
+// usage: go run ./internal/convert neetchamp.swagger.json neetchamp.openapi.json
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: convert <swagger.json> <openapi.json>")
+	}
+	data, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	var v2 openapi2.T
+	if err := json.Unmarshal(data, &v2); err != nil {
+		log.Fatalf("%s: %v", os.Args[1], err)
+	}
+	v3, err := openapi2conv.ToV3(&v2)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Swagger 2 can only describe the Authorization header as an API key;
+	// OpenAPI 3 knows bearer tokens, so Swagger UI adds the "Bearer " prefix
+	for _, scheme := range v3.Components.SecuritySchemes {
+		if s := scheme.Value; s != nil && s.Type == "apiKey" && s.In == "header" && s.Name == "Authorization" {
+			scheme.Value = openapi3.NewJWTSecurityScheme().WithDescription("Access token from Login")
+		}
+	}
+	out, err := json.MarshalIndent(v3, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(os.Args[2], append(out, '\n'), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,20 @@
+// Package openapi embeds the REST API documents generated from the protos in
+// shared-libs/proto, merged into one spec for all services
+package openapi
+
+import (
+	_ "embed"
+)
+
+//go:generate protoc -I ../proto --openapiv2_out=allow_merge=true,merge_file_name=neetchamp:. auth/auth.proto user/user.proto
+//go:generate go run ./internal/convert neetchamp.swagger.json neetchamp.openapi.json
+
+// V2 is the OpenAPI v2 (Swagger) document
+//
+//go:embed neetchamp.swagger.json
+var V2 []byte
+
+// V3 is the same API as an OpenAPI 3 document
+//
+//go:embed neetchamp.openapi.json
+var V3 []byte
@@ -0,0 +1,342 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v3.21.12
+// source: authz/authz.proto
+
+package authz
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Fixed set of platform roles. Stored in the users table by canonical name
+// ("student", "mentor", "content_editor", "admin").
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED    Role = 0
+	Role_ROLE_STUDENT        Role = 1
+	Role_ROLE_MENTOR         Role = 2
+	Role_ROLE_CONTENT_EDITOR Role = 3
+	Role_ROLE_ADMIN          Role = 4
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_STUDENT",
+		2: "ROLE_MENTOR",
+		3: "ROLE_CONTENT_EDITOR",
+		4: "ROLE_ADMIN",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED":    0,
+		"ROLE_STUDENT":        1,
+		"ROLE_MENTOR":         2,
+		"ROLE_CONTENT_EDITOR": 3,
+		"ROLE_ADMIN":          4,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_authz_authz_proto_enumTypes[0].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_authz_authz_proto_enumTypes[0]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_authz_authz_proto_rawDescGZIP(), []int{0}
+}
+
+// Capabilities granted to roles (see shared-libs/authz) or to individual users
+// through permission grants.
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED     Permission = 0
+	Permission_PERMISSION_PROFILES_READ   Permission = 1  // Read any user's profile
+	Permission_PERMISSION_PROFILES_WRITE  Permission = 2  // Update any user's profile
+	Permission_PERMISSION_USERS_DELETE    Permission = 3  // Delete accounts
+	Permission_PERMISSION_ROLES_MANAGE    Permission = 4  // Change roles and permission grants
+	Permission_PERMISSION_QUESTIONS_READ  Permission = 5  // Browse the question bank
+	Permission_PERMISSION_QUESTIONS_WRITE Permission = 6  // Create and edit questions
+	Permission_PERMISSION_QUIZZES_PLAY    Permission = 7  // Attempt quizzes
+	Permission_PERMISSION_MENTEES_VIEW    Permission = 8  // View mentees' progress
+	Permission_PERMISSION_AUDIT_READ      Permission = 9  // Read the security audit log
+	Permission_PERMISSION_COHORTS_MANAGE  Permission = 10 // Create cohorts and move students between them
+	Permission_PERMISSION_ACTIVITY_RECORD Permission = 11 // Record quiz activity (XP, streaks, badges) for users
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0:  "PERMISSION_UNSPECIFIED",
+		1:  "PERMISSION_PROFILES_READ",
+		2:  "PERMISSION_PROFILES_WRITE",
+		3:  "PERMISSION_USERS_DELETE",
+		4:  "PERMISSION_ROLES_MANAGE",
+		5:  "PERMISSION_QUESTIONS_READ",
+		6:  "PERMISSION_QUESTIONS_WRITE",
+		7:  "PERMISSION_QUIZZES_PLAY",
+		8:  "PERMISSION_MENTEES_VIEW",
+		9:  "PERMISSION_AUDIT_READ",
+		10: "PERMISSION_COHORTS_MANAGE",
+		11: "PERMISSION_ACTIVITY_RECORD",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED":     0,
+		"PERMISSION_PROFILES_READ":   1,
+		"PERMISSION_PROFILES_WRITE":  2,
+		"PERMISSION_USERS_DELETE":    3,
+		"PERMISSION_ROLES_MANAGE":    4,
+		"PERMISSION_QUESTIONS_READ":  5,
+		"PERMISSION_QUESTIONS_WRITE": 6,
+		"PERMISSION_QUIZZES_PLAY":    7,
+		"PERMISSION_MENTEES_VIEW":    8,
+		"PERMISSION_AUDIT_READ":      9,
+		"PERMISSION_COHORTS_MANAGE":  10,
+		"PERMISSION_ACTIVITY_RECORD": 11,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_authz_authz_proto_enumTypes[1].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_authz_authz_proto_enumTypes[1]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_authz_authz_proto_rawDescGZIP(), []int{1}
+}
+
+// Access rule for an RPC, declared with `option (authz.access) = { ... };`
+type AccessRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Permissions the caller must hold (all of them)
+	Permissions []Permission `protobuf:"varint,1,rep,packed,name=permissions,proto3,enum=authz.Permission" json:"permissions,omitempty"`
+	// Request field holding a user ID; when it names the caller (or is empty,
+	// meaning "me") the permissions above are not required.
+	SelfField     string `protobuf:"bytes,2,opt,name=self_field,json=selfField,proto3" json:"self_field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRule) Reset() {
+	*x = AccessRule{}
+	mi := &file_authz_authz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRule) ProtoMessage() {}
+
+func (x *AccessRule) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_authz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRule.ProtoReflect.Descriptor instead.
+func (*AccessRule) Descriptor() ([]byte, []int) {
+	return file_authz_authz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessRule) GetPermissions() []Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AccessRule) GetSelfField() string {
+	if x != nil {
+		return x.SelfField
+	}
+	return ""
+}
+
+var file_authz_authz_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*AccessRule)(nil),
+		Field:         50100,
+		Name:          "authz.access",
+		Tag:           "bytes,50100,opt,name=access",
+		Filename:      "authz/authz.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional authz.AccessRule access = 50100;
+	E_Access = &file_authz_authz_proto_extTypes[0]
+)
+
+var File_authz_authz_proto protoreflect.FileDescriptor
+
+var file_authz_authz_proto_rawDesc = string([]byte{
+	0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x60, 0x0a, 0x0a,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2a, 0x68,
+	0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x4e, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f,
+	0x45, 0x44, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x04, 0x2a, 0xf2, 0x02, 0x0a, 0x0a, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x45, 0x52, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
+	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x1b, 0x0a,
+	0x17, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x53, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x45,
+	0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f,
+	0x4e, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x45, 0x52,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e,
+	0x53, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x45, 0x52,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x49, 0x5a, 0x5a, 0x45, 0x53, 0x5f,
+	0x50, 0x4c, 0x41, 0x59, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x45, 0x53, 0x5f, 0x56, 0x49, 0x45,
+	0x57, 0x10, 0x08, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x09, 0x12, 0x1d,
+	0x0a, 0x19, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x48,
+	0x4f, 0x52, 0x54, 0x53, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x10, 0x0a, 0x12, 0x1e, 0x0a,
+	0x1a, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x10, 0x0b, 0x3a, 0x4b, 0x0a,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb4, 0x87, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x64, 0x69, 0x74, 0x79, 0x61, 0x2d,
+	0x50, 0x53, 0x2d, 0x30, 0x35, 0x2f, 0x4e, 0x65, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x70, 0x2f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2d, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_authz_authz_proto_rawDescOnce sync.Once
+	file_authz_authz_proto_rawDescData []byte
+)
+
+func file_authz_authz_proto_rawDescGZIP() []byte {
+	file_authz_authz_proto_rawDescOnce.Do(func() {
+		file_authz_authz_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_authz_authz_proto_rawDesc), len(file_authz_authz_proto_rawDesc)))
+	})
+	return file_authz_authz_proto_rawDescData
+}
+
+var file_authz_authz_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_authz_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_authz_authz_proto_goTypes = []any{
+	(Role)(0),                          // 0: authz.Role
+	(Permission)(0),                    // 1: authz.Permission
+	(*AccessRule)(nil),                 // 2: authz.AccessRule
+	(*descriptorpb.MethodOptions)(nil), // 3: google.protobuf.MethodOptions
+}
+var file_authz_authz_proto_depIdxs = []int32{
+	1, // 0: authz.AccessRule.permissions:type_name -> authz.Permission
+	3, // 1: authz.access:extendee -> google.protobuf.MethodOptions
+	2, // 2: authz.access:type_name -> authz.AccessRule
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	2, // [2:3] is the sub-list for extension type_name
+	1, // [1:2] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_authz_authz_proto_init() }
+func file_authz_authz_proto_init() {
+	if File_authz_authz_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authz_authz_proto_rawDesc), len(file_authz_authz_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_authz_authz_proto_goTypes,
+		DependencyIndexes: file_authz_authz_proto_depIdxs,
+		EnumInfos:         file_authz_authz_proto_enumTypes,
+		MessageInfos:      file_authz_authz_proto_msgTypes,
+		ExtensionInfos:    file_authz_authz_proto_extTypes,
+	}.Build()
+	File_authz_authz_proto = out.File
+	file_authz_authz_proto_goTypes = nil
+	file_authz_authz_proto_depIdxs = nil
+}